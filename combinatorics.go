@@ -0,0 +1,178 @@
+package slice
+
+import "golang.org/x/exp/constraints"
+
+// CartesianProduct calls f with every tuple of the cartesian product of the input slices,
+// in lexicographic order of the input positions. The i-th element of each tuple is taken from ss[i].
+// The slice passed to f is reused between calls and must be cloned if retained.
+// Iteration stops early if f returns false.
+func CartesianProduct[S ~[]E, E any](f func(S) bool, ss ...S) {
+	for _, s := range ss {
+		if len(s) == 0 {
+			return
+		}
+	}
+
+	idx := make([]int, len(ss))
+	buf := make(S, len(ss))
+	for i, s := range ss {
+		buf[i] = s[0]
+	}
+
+	for {
+		if !f(buf) {
+			return
+		}
+
+		i := len(ss) - 1
+		for ; i >= 0; i-- {
+			idx[i]++
+			if idx[i] < len(ss[i]) {
+				buf[i] = ss[i][idx[i]]
+				break
+			}
+			idx[i] = 0
+			buf[i] = ss[i][0]
+		}
+		if i < 0 {
+			return
+		}
+	}
+}
+
+// Combinations calls f with every k-element combination of s, in lexicographic order of positions.
+// The slice passed to f is reused between calls and must be cloned if retained.
+// Iteration stops early if f returns false.
+func Combinations[S ~[]E, E any](s S, k int, f func(S) bool) {
+	combinations(s, k, f)
+}
+
+func combinations[S ~[]E, E any](s S, k int, f func(S) bool) bool {
+	n := len(s)
+	if k < 0 || k > n {
+		return true
+	}
+
+	idx := Range(0, k)
+	buf := make(S, k)
+	for i, j := range idx {
+		buf[i] = s[j]
+	}
+
+	for {
+		if !f(buf) {
+			return false
+		}
+
+		i := k - 1
+		for i >= 0 && idx[i] == n-k+i {
+			i--
+		}
+		if i < 0 {
+			return true
+		}
+
+		idx[i]++
+		for j := i + 1; j < k; j++ {
+			idx[j] = idx[j-1] + 1
+		}
+		for j := i; j < k; j++ {
+			buf[j] = s[idx[j]]
+		}
+	}
+}
+
+// CombinationsWithReplacement calls f with every k-element combination of s in which elements may repeat,
+// in lexicographic order of positions.
+// The slice passed to f is reused between calls and must be cloned if retained.
+// Iteration stops early if f returns false.
+func CombinationsWithReplacement[S ~[]E, E any](s S, k int, f func(S) bool) {
+	n := len(s)
+	if k < 0 || (n == 0 && k > 0) {
+		return
+	}
+
+	idx := make([]int, k)
+	buf := make(S, k)
+	for i := range buf {
+		buf[i] = s[0]
+	}
+
+	for {
+		if !f(buf) {
+			return
+		}
+
+		i := k - 1
+		for i >= 0 && idx[i] == n-1 {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+
+		idx[i]++
+		for j := i + 1; j < k; j++ {
+			idx[j] = idx[i]
+		}
+		for j := i; j < k; j++ {
+			buf[j] = s[idx[j]]
+		}
+	}
+}
+
+// NextPermutation rearranges s in place into the next lexicographically greater permutation.
+// If s is already the greatest permutation, it is rearranged into the smallest one and false is returned.
+func NextPermutation[S ~[]E, E constraints.Ordered](s S) bool {
+	return NextPermutationFunc(s, func(a, b E) bool { return a < b })
+}
+
+// NextPermutationFunc is like NextPermutation, but orders elements using the given less function.
+func NextPermutationFunc[S ~[]E, E any](s S, less func(a, b E) bool) bool {
+	i := len(s) - 2
+	for i >= 0 && !less(s[i], s[i+1]) {
+		i--
+	}
+	if i < 0 {
+		Reverse(s)
+		return false
+	}
+
+	j := len(s) - 1
+	for !less(s[i], s[j]) {
+		j--
+	}
+
+	s[i], s[j] = s[j], s[i]
+	Reverse(s[i+1:])
+	return true
+}
+
+// Permutations calls f with every permutation of s, in lexicographic order of positions.
+// Elements are treated as distinct by position, so s with repeated values yields repeated permutations.
+// The slice passed to f is reused between calls and must be cloned if retained.
+// Iteration stops early if f returns false.
+func Permutations[S ~[]E, E any](s S, f func(S) bool) {
+	idx := Range(0, len(s))
+	buf := make(S, len(s))
+
+	for {
+		for i, j := range idx {
+			buf[i] = s[j]
+		}
+		if !f(buf) || !NextPermutation(idx) {
+			return
+		}
+	}
+}
+
+// PowerSet calls f with every subset of s, ordered by size and then by position.
+// The slice passed to f is reused between calls and must be cloned if retained.
+// Iteration stops early if f returns false.
+func PowerSet[S ~[]E, E any](s S, f func(S) bool) {
+	for k := 0; k <= len(s); k++ {
+		if !combinations(s, k, f) {
+			return
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func collect(r *[][]int) func([]int) bool {
+	return func(s []int) bool {
+		*r = append(*r, slice.Clone(s))
+		return true
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	testCases := []struct {
+		ss   [][]int
+		want [][]int
+	}{
+		{[][]int{{1, 2}, {3, 4}}, [][]int{{1, 3}, {1, 4}, {2, 3}, {2, 4}}},
+		{[][]int{{1}, {2, 3}, {4}}, [][]int{{1, 2, 4}, {1, 3, 4}}},
+		{[][]int{{1, 2}, {}}, nil},
+		{nil, [][]int{nil}},
+	}
+
+	for _, c := range testCases {
+		var r [][]int
+		if slice.CartesianProduct(collect(&r), c.ss...); !equals2D(r, c.want) {
+			t.Errorf("CartesianProduct(%v) = %v, want %v", c.ss, r, c.want)
+		}
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		k    int
+		want [][]int
+	}{
+		{[]int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}},
+		{[]int{1, 2, 3}, 3, [][]int{{1, 2, 3}}},
+		{[]int{1, 2, 3}, 0, [][]int{nil}},
+		{[]int{1, 2, 3}, 4, nil},
+		{nil, 1, nil},
+	}
+
+	for _, c := range testCases {
+		var r [][]int
+		if slice.Combinations(c.s, c.k, collect(&r)); !equals2D(r, c.want) {
+			t.Errorf("Combinations(%v, %v) = %v, want %v", c.s, c.k, r, c.want)
+		}
+	}
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		k    int
+		want [][]int
+	}{
+		{[]int{1, 2, 3}, 2, [][]int{{1, 1}, {1, 2}, {1, 3}, {2, 2}, {2, 3}, {3, 3}}},
+		{[]int{1}, 3, [][]int{{1, 1, 1}}},
+		{[]int{1, 2}, 0, [][]int{nil}},
+		{nil, 1, nil},
+	}
+
+	for _, c := range testCases {
+		var r [][]int
+		if slice.CombinationsWithReplacement(c.s, c.k, collect(&r)); !equals2D(r, c.want) {
+			t.Errorf("CombinationsWithReplacement(%v, %v) = %v, want %v", c.s, c.k, r, c.want)
+		}
+	}
+}
+
+func TestNextPermutation(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		want []int
+		ok   bool
+	}{
+		{[]int{1, 2, 3}, []int{1, 3, 2}, true},
+		{[]int{1, 3, 2}, []int{2, 1, 3}, true},
+		{[]int{1, 1, 2}, []int{1, 2, 1}, true},
+		{[]int{3, 2, 1}, []int{1, 2, 3}, false},
+		{[]int{1}, []int{1}, false},
+		{nil, nil, false},
+	}
+
+	for _, c := range testCases {
+		s := slice.Clone(c.s)
+		if ok := slice.NextPermutation(s); ok != c.ok || !slice.Equals(s, c.want) {
+			t.Errorf("NextPermutation(%v) = %v, %v, want %v, %v", c.s, s, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		want [][]int
+	}{
+		{[]int{1, 2, 3}, [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}}},
+		{[]int{3, 1}, [][]int{{3, 1}, {1, 3}}},
+		{nil, [][]int{nil}},
+	}
+
+	for _, c := range testCases {
+		var r [][]int
+		if slice.Permutations(c.s, collect(&r)); !equals2D(r, c.want) {
+			t.Errorf("Permutations(%v) = %v, want %v", c.s, r, c.want)
+		}
+	}
+
+	var n int
+	slice.Permutations([]int{1, 2, 3, 4}, func([]int) bool {
+		n++
+		return n < 5
+	})
+	if n != 5 {
+		t.Errorf("Permutations did not stop early, called %v times", n)
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		want [][]int
+	}{
+		{[]int{1, 2, 3}, [][]int{nil, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}}},
+		{nil, [][]int{nil}},
+	}
+
+	for _, c := range testCases {
+		var r [][]int
+		if slice.PowerSet(c.s, collect(&r)); !equals2D(r, c.want) {
+			t.Errorf("PowerSet(%v) = %v, want %v", c.s, r, c.want)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package slice
+
+import "golang.org/x/exp/constraints"
+
+// Comparator compares two values and returns a negative number if a orders before b,
+// a positive number if a orders after b, and zero if they are equal in order.
+// Comparators are built with By, ByDesc and ByPtr and chained with Then.
+type Comparator[E any] func(a, b E) int
+
+// NilOrder specifies where ByPtr places elements whose key is nil.
+type NilOrder int
+
+const (
+	// NilsFirst orders nil keys before all non-nil keys.
+	NilsFirst NilOrder = iota
+	// NilsLast orders nil keys after all non-nil keys.
+	NilsLast
+)
+
+// By returns a Comparator that orders elements by increasing key.
+func By[E any, K constraints.Ordered](key func(E) K) Comparator[E] {
+	return func(a, b E) int {
+		return compare(key(a), key(b))
+	}
+}
+
+// ByDesc returns a Comparator that orders elements by decreasing key.
+func ByDesc[E any, K constraints.Ordered](key func(E) K) Comparator[E] {
+	return By(key).Reverse()
+}
+
+// ByPtr returns a Comparator that orders elements by increasing pointed-to key,
+// placing elements with a nil key according to nils.
+func ByPtr[E any, K constraints.Ordered](key func(E) *K, nils NilOrder) Comparator[E] {
+	return func(a, b E) int {
+		ka, kb := key(a), key(b)
+		switch {
+		case ka == nil && kb == nil:
+			return 0
+		case ka == nil && nils == NilsFirst, kb == nil && nils == NilsLast:
+			return -1
+		case ka == nil, kb == nil:
+			return 1
+		}
+		return compare(*ka, *kb)
+	}
+}
+
+// Less reports whether a orders before b. The method value c.Less can be passed to Sort and SortStable.
+func (c Comparator[E]) Less(a, b E) bool {
+	return c(a, b) < 0
+}
+
+// Reverse returns a Comparator with the opposite order of c.
+func (c Comparator[E]) Reverse() Comparator[E] {
+	return func(a, b E) int {
+		return c(b, a)
+	}
+}
+
+// Then returns a Comparator that orders by c, and then by next for elements that c considers equal.
+func (c Comparator[E]) Then(next Comparator[E]) Comparator[E] {
+	return func(a, b E) int {
+		if r := c(a, b); r != 0 {
+			return r
+		}
+		return next(a, b)
+	}
+}
+
+func compare[T constraints.Ordered](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
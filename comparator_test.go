@@ -0,0 +1,65 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+type reportRow struct {
+	dept   string
+	salary int
+	name   string
+	bonus  *int
+}
+
+func TestComparatorThen(t *testing.T) {
+	s := []reportRow{
+		{"ops", 100, "carol", nil},
+		{"dev", 100, "bob", nil},
+		{"dev", 200, "dave", nil},
+		{"ops", 100, "alice", nil},
+		{"dev", 100, "adam", nil},
+	}
+	want := []string{"dave", "adam", "bob", "alice", "carol"}
+
+	c := slice.By(func(r reportRow) string { return r.dept }).
+		Then(slice.ByDesc(func(r reportRow) int { return r.salary })).
+		Then(slice.By(func(r reportRow) string { return r.name }))
+	slice.Sort(s, c.Less)
+
+	if r := slice.Map(s, func(r reportRow) string { return r.name }); !slice.Equals(r, want) {
+		t.Errorf("got %v, want %v", r, want)
+	}
+}
+
+func TestComparatorReverse(t *testing.T) {
+	s, want := []int{2, 3, 1}, []int{3, 2, 1}
+	slice.Sort(s, slice.By(func(v int) int { return v }).Reverse().Less)
+	if !slice.Equals(s, want) {
+		t.Errorf("got %v, want %v", s, want)
+	}
+}
+
+func TestByPtr(t *testing.T) {
+	one, two := 1, 2
+	s := []reportRow{{name: "a", bonus: &two}, {name: "b"}, {name: "c", bonus: &one}}
+	name := func(r reportRow) string { return r.name }
+	bonus := func(r reportRow) *int { return r.bonus }
+
+	testCases := []struct {
+		nils slice.NilOrder
+		want []string
+	}{
+		{slice.NilsFirst, []string{"b", "c", "a"}},
+		{slice.NilsLast, []string{"c", "a", "b"}},
+	}
+
+	for _, c := range testCases {
+		r := slice.Clone(s)
+		slice.SortStable(r, slice.ByPtr(bonus, c.nils).Less)
+		if names := slice.Map(r, name); !slice.Equals(names, c.want) {
+			t.Errorf("ByPtr(%v) = %v, want %v", c.nils, names, c.want)
+		}
+	}
+}
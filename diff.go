@@ -0,0 +1,202 @@
+package slice
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EditOp is the kind of operation in an edit script.
+type EditOp int
+
+const (
+	// EditKeep keeps an element that is present in both slices.
+	EditKeep EditOp = iota
+	// EditInsert inserts an element from the second slice.
+	EditInsert
+	// EditDelete deletes an element from the first slice.
+	EditDelete
+)
+
+// String returns the name of the operation.
+func (op EditOp) String() string {
+	switch op {
+	case EditKeep:
+		return "keep"
+	case EditInsert:
+		return "insert"
+	case EditDelete:
+		return "delete"
+	}
+	return fmt.Sprintf("EditOp(%d)", int(op))
+}
+
+// Edit is a single operation of an edit script that transforms slice a into slice b.
+// A and B are the positions in a and b at which the operation applies; for an insert A is the
+// position in a before which the element is inserted, and for a delete B is the position in b
+// at which the element would have been. Value is the element that is kept, inserted or deleted.
+type Edit[E any] struct {
+	Op    EditOp
+	A, B  int
+	Value E
+}
+
+// Diff returns a minimal edit script that transforms a into b, computed with Myers' algorithm.
+// It returns nil if both slices are empty.
+func Diff[S ~[]E, E comparable](a, b S) []Edit[E] {
+	return DiffFunc(a, b, func(x, y E) bool { return x == y })
+}
+
+// DiffFunc is like Diff, but compares elements using the given eq function.
+func DiffFunc[S ~[]E, E any](a, b S, eq func(x, y E) bool) []Edit[E] {
+	n, m := len(a), len(b)
+	if n == 0 && m == 0 {
+		return nil
+	}
+
+	max := n + m
+	off := max + 1
+	v := make([]int, 2*max+3)
+	// trace[d] holds the diagonals -d-1..d+1 of v as they were before step d,
+	// which is all the backtracking needs, so memory stays O(D²) rather than O((N+M)·D).
+	var trace [][]int
+
+search:
+	for d := 0; d <= max; d++ {
+		trace = append(trace, Clone(v[off-d-1:off+d+2]))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[off+k-1] < v[off+k+1]) {
+				x = v[off+k+1]
+			} else {
+				x = v[off+k-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && eq(a[x], b[y]) {
+				x++
+				y++
+			}
+
+			v[off+k] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	script := make([]Edit[E], 0, max)
+	x, y := n, m
+
+	for d := len(trace) - 1; d >= 0; d-- {
+		v, off := trace[d], d+1
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && v[off+k-1] < v[off+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+
+		prevX := v[off+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			script = append(script, Edit[E]{EditKeep, x, y, a[x]})
+		}
+
+		if d > 0 {
+			if x == prevX {
+				y--
+				script = append(script, Edit[E]{EditInsert, x, y, b[y]})
+			} else {
+				x--
+				script = append(script, Edit[E]{EditDelete, x, y, a[x]})
+			}
+		}
+	}
+
+	Reverse(script)
+	return script
+}
+
+// Patch applies the edit script to a and returns the resulting slice.
+// Applying the script returned by Diff(a, b) to a yields a copy of b.
+func Patch[S ~[]E, E any](a S, script []Edit[E]) S {
+	var r S
+	for _, e := range script {
+		switch e.Op {
+		case EditKeep:
+			r = append(r, a[e.A])
+		case EditInsert:
+			r = append(r, e.Value)
+		}
+	}
+	return r
+}
+
+// UnifiedDiff returns the differences between a and b in unified diff format, with the given
+// number of context lines around each change. A negative context is treated as zero.
+// It returns an empty string if a and b are equal.
+func UnifiedDiff[S ~[]E, E ~string](fromName, toName string, a, b S, context int) string {
+	context = maxInt(context, 0)
+	script := Diff(a, b)
+	if Every(script, func(e Edit[E]) bool { return e.Op == EditKeep }) {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromName, toName)
+
+	for i := 0; i < len(script); {
+		if script[i].Op == EditKeep {
+			i++
+			continue
+		}
+
+		start := maxInt(i-context, 0)
+
+		end := i
+		for j := i; j < len(script); j++ {
+			if script[j].Op != EditKeep {
+				end = j + 1
+			} else if j-end >= 2*context {
+				break
+			}
+		}
+		i = end
+		if end += context; end > len(script) {
+			end = len(script)
+		}
+
+		hunk := script[start:end]
+		aLen := Count(hunk, func(e Edit[E]) bool { return e.Op != EditInsert })
+		bLen := Count(hunk, func(e Edit[E]) bool { return e.Op != EditDelete })
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", hunkRange(hunk[0].A, aLen), hunkRange(hunk[0].B, bLen))
+
+		for _, e := range hunk {
+			switch e.Op {
+			case EditKeep:
+				sb.WriteByte(' ')
+			case EditInsert:
+				sb.WriteByte('+')
+			case EditDelete:
+				sb.WriteByte('-')
+			}
+			sb.WriteString(string(e.Value))
+			sb.WriteByte('\n')
+		}
+	}
+
+	return sb.String()
+}
+
+func hunkRange(start, n int) string {
+	if n == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	return fmt.Sprintf("%d,%d", start+1, n)
+}
@@ -0,0 +1,124 @@
+package slice_test
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func TestDiff(t *testing.T) {
+	testCases := []struct {
+		a, b  []string
+		edits int
+	}{
+		{strings.Split("ABCABBA", ""), strings.Split("CBABAC", ""), 5},
+		{[]string{"a", "b", "c"}, []string{"a", "b", "c"}, 0},
+		{[]string{"a", "b", "c"}, nil, 3},
+		{nil, []string{"a", "b"}, 2},
+		{[]string{"a", "b", "c"}, []string{"a", "x", "c"}, 2},
+		{nil, nil, 0},
+	}
+
+	for _, c := range testCases {
+		script := slice.Diff(c.a, c.b)
+		if n := slice.Count(script, func(e slice.Edit[string]) bool { return e.Op != slice.EditKeep }); n != c.edits {
+			t.Errorf("Diff(%v, %v) has %v edits, want %v", c.a, c.b, n, c.edits)
+		}
+		if r := slice.Patch(c.a, script); !slice.Equals(r, c.b) {
+			t.Errorf("Patch(%v, Diff(%v, %v)) = %v, want %v", c.a, c.a, c.b, r, c.b)
+		}
+	}
+}
+
+func TestDiffFunc(t *testing.T) {
+	a := []string{"A", "b", "C"}
+	b := []string{"a", "B", "c", "d"}
+
+	script := slice.DiffFunc(a, b, strings.EqualFold)
+	want := []slice.EditOp{slice.EditKeep, slice.EditKeep, slice.EditKeep, slice.EditInsert}
+
+	if r := slice.Map(script, func(e slice.Edit[string]) slice.EditOp { return e.Op }); !slice.Equals(r, want) {
+		t.Errorf("DiffFunc(%v, %v) = %v, want %v", a, b, r, want)
+	}
+}
+
+func TestDiffLarge(t *testing.T) {
+	const n, changes = 20000, 1000
+
+	a := make([]int, n)
+	b := make([]int, n)
+	for i := range a {
+		a[i], b[i] = i, i
+	}
+	for i := 0; i < changes; i++ {
+		b[i*(n/changes)] = -1 - i
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	script := slice.Diff(a, b)
+	runtime.ReadMemStats(&after)
+
+	if n := slice.Count(script, func(e slice.Edit[int]) bool { return e.Op != slice.EditKeep }); n != 2*changes {
+		t.Errorf("Diff has %v edits, want %v", n, 2*changes)
+	}
+	if r := slice.Patch(a, script); !slice.Equals(r, b) {
+		t.Errorf("Patch(a, Diff(a, b)) != b")
+	}
+	if alloc := after.TotalAlloc - before.TotalAlloc; alloc > 64<<20 {
+		t.Errorf("Diff allocated %v bytes, want at most %v", alloc, 64<<20)
+	}
+}
+
+func TestPatch(t *testing.T) {
+	a := []int{1, 2, 3}
+	script := []slice.Edit[int]{
+		{Op: slice.EditDelete, A: 0, B: 0, Value: 1},
+		{Op: slice.EditKeep, A: 1, B: 0, Value: 2},
+		{Op: slice.EditInsert, A: 2, B: 1, Value: 5},
+		{Op: slice.EditKeep, A: 2, B: 2, Value: 3},
+	}
+	want := []int{2, 5, 3}
+
+	if r := slice.Patch(a, script); !slice.Equals(r, want) {
+		t.Errorf("Patch(%v, %v) = %v, want %v", a, script, r, want)
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	testCases := []struct {
+		a, b    []string
+		context int
+		want    string
+	}{
+		{
+			[]string{"a", "b", "c", "d", "e", "f", "g", "h"},
+			[]string{"a", "B", "c", "d", "e", "f", "g", "h", "i"},
+			1,
+			"--- old\n+++ new\n" +
+				"@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n" +
+				"@@ -8,1 +8,2 @@\n h\n+i\n",
+		},
+		{
+			[]string{"a", "b", "c"},
+			[]string{"a", "c"},
+			0,
+			"--- old\n+++ new\n@@ -2,1 +1,0 @@\n-b\n",
+		},
+		{
+			[]string{"a", "b", "c", "d"},
+			[]string{"a", "c", "d", "e"},
+			-2,
+			"--- old\n+++ new\n@@ -2,1 +1,0 @@\n-b\n@@ -4,0 +4,1 @@\n+e\n",
+		},
+		{[]string{"a"}, []string{"a"}, 3, ""},
+	}
+
+	for _, c := range testCases {
+		if r := slice.UnifiedDiff("old", "new", c.a, c.b, c.context); r != c.want {
+			t.Errorf("UnifiedDiff(%v, %v, %v) = %q, want %q", c.a, c.b, c.context, r, c.want)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package slice
+
+// DamerauLevenshtein returns the Damerau-Levenshtein distance between a and b, which is the minimum
+// number of insertions, deletions, substitutions and transpositions of adjacent elements needed to
+// transform a into b.
+func DamerauLevenshtein[S ~[]E, E comparable](a, b S) int {
+	n, m := len(a), len(b)
+	inf := n + m
+
+	d := Make2D[int](n+2, m+2)
+	d[0][0] = inf
+	for i := 0; i <= n; i++ {
+		d[i+1][0] = inf
+		d[i+1][1] = i
+	}
+	for j := 0; j <= m; j++ {
+		d[0][j+1] = inf
+		d[1][j+1] = j
+	}
+
+	last := make(map[E]int)
+	for i := 1; i <= n; i++ {
+		var lastMatch int
+		for j := 1; j <= m; j++ {
+			k, l := last[b[j-1]], lastMatch
+
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+				lastMatch = j
+			}
+
+			d[i+1][j+1] = minInt(d[i][j]+cost, d[i+1][j]+1, d[i][j+1]+1, d[k][l]+(i-k-1)+1+(j-l-1))
+		}
+		last[a[i-1]] = i
+	}
+
+	return d[n+1][m+1]
+}
+
+// DamerauLevenshteinMax is like DamerauLevenshtein, but only computes the band of width 2*max+1
+// around the diagonal and stops early once the distance is known to exceed max.
+// It returns the distance and true if the distance is at most max, or max+1 and false otherwise.
+func DamerauLevenshteinMax[S ~[]E, E comparable](a, b S, max int) (int, bool) {
+	n, m := len(a), len(b)
+	if max < 0 || abs(n-m) > max {
+		return max + 1, false
+	}
+
+	// A transposition reaching back more than max+1 rows or columns costs more than max,
+	// so only the last max+3 rows of the band are kept. rows[i%len(rows)][j-i+max] holds
+	// the distance between a[:i] and b[:j], capped at inf.
+	inf := max + 1
+	width := 2*max + 1
+	rows := make([][]int, max+3)
+	for i := range rows {
+		rows[i] = make([]int, width)
+	}
+	at := func(i, j int) int {
+		if i < 0 || j < 0 || abs(i-j) > max {
+			return inf
+		}
+		return rows[i%len(rows)][j-i+max]
+	}
+
+	for j := range rows[0] {
+		rows[0][j] = inf
+	}
+	for j := 0; j <= minInt(m, max); j++ {
+		rows[0][j+max] = j
+	}
+
+	last := make(map[E]int)
+	for i := 1; i <= n; i++ {
+		row := rows[i%len(rows)]
+		for j := range row {
+			row[j] = inf
+		}
+
+		lo, hi := maxInt(1, i-max), minInt(m, i+max)
+		rowMin := inf
+		if lo == 1 && i <= max {
+			row[max-i] = i
+			rowMin = i
+		}
+
+		var lastMatch int
+		for j := lo; j <= hi; j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			v := minInt(at(i-1, j-1)+cost, at(i, j-1)+1, at(i-1, j)+1, inf)
+			if k, l := last[b[j-1]], lastMatch; k > 0 && l > 0 && i-k-1 <= max && j-l-1 <= max {
+				v = minInt(v, at(k-1, l-1)+(i-k-1)+1+(j-l-1))
+			}
+			if cost == 0 {
+				lastMatch = j
+			}
+
+			row[j-i+max] = v
+			rowMin = minInt(rowMin, v)
+		}
+
+		if rowMin > max {
+			return inf, false
+		}
+		last[a[i-1]] = i
+	}
+
+	if r := at(n, m); r <= max {
+		return r, true
+	}
+	return inf, false
+}
+
+// DiceSimilarity returns the Sørensen-Dice coefficient of the sets of elements of a and b,
+// which is twice the size of their intersection divided by the sum of their sizes.
+// It returns 1 if both slices are empty.
+func DiceSimilarity[S ~[]E, E comparable](a, b S) float64 {
+	inter, sa, sb := setSizes(a, b)
+	if sa+sb == 0 {
+		return 1
+	}
+	return float64(2*inter) / float64(sa+sb)
+}
+
+// Hamming returns the number of positions at which the elements of a and b differ,
+// or -1 if the slices have different lengths.
+func Hamming[S ~[]E, E comparable](a, b S) int {
+	if len(a) != len(b) {
+		return -1
+	}
+
+	var d int
+	for i, v := range a {
+		if v != b[i] {
+			d++
+		}
+	}
+	return d
+}
+
+// JaccardSimilarity returns the Jaccard index of the sets of elements of a and b,
+// which is the size of their intersection divided by the size of their union.
+// It returns 1 if both slices are empty.
+func JaccardSimilarity[S ~[]E, E comparable](a, b S) float64 {
+	inter, sa, sb := setSizes(a, b)
+	if sa+sb == 0 {
+		return 1
+	}
+	return float64(inter) / float64(sa+sb-inter)
+}
+
+// LevenshteinDistance returns the Levenshtein distance between a and b, which is the minimum number
+// of insertions, deletions and substitutions needed to transform a into b.
+func LevenshteinDistance[S ~[]E, E comparable](a, b S) int {
+	prev := Range(0, len(b)+1)
+	cur := make([]int, len(b)+1)
+
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j-1]+cost, prev[j]+1, cur[j-1]+1)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(b)]
+}
+
+// LevenshteinDistanceMax is like LevenshteinDistance, but only computes the band of width 2*max+1
+// around the diagonal and stops early once the distance is known to exceed max.
+// It returns the distance and true if the distance is at most max, or max+1 and false otherwise.
+func LevenshteinDistanceMax[S ~[]E, E comparable](a, b S, max int) (int, bool) {
+	n, m := len(a), len(b)
+	if max < 0 || abs(n-m) > max {
+		return max + 1, false
+	}
+
+	inf := max + 1
+	prev := make([]int, m+1)
+	cur := make([]int, m+1)
+	for j := range prev {
+		prev[j] = minInt(j, inf)
+	}
+
+	for i := 1; i <= n; i++ {
+		lo, hi := maxInt(1, i-max), minInt(m, i+max)
+
+		cur[lo-1] = inf
+		if lo == 1 {
+			cur[0] = minInt(i, inf)
+		}
+		rowMin := cur[lo-1]
+
+		for j := lo; j <= hi; j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j-1]+cost, prev[j]+1, cur[j-1]+1, inf)
+			rowMin = minInt(rowMin, cur[j])
+		}
+		if hi < m {
+			cur[hi+1] = inf
+		}
+
+		if rowMin > max {
+			return inf, false
+		}
+		prev, cur = cur, prev
+	}
+
+	if prev[m] > max {
+		return inf, false
+	}
+	return prev[m], true
+}
+
+// LongestCommonSubsequence returns a longest subsequence common to a and b.
+func LongestCommonSubsequence[S ~[]E, E comparable](a, b S) S {
+	var r S
+	for _, e := range Diff(a, b) {
+		if e.Op == EditKeep {
+			r = append(r, e.Value)
+		}
+	}
+	return r
+}
+
+// setSizes returns the size of the intersection of the sets of elements of a and b,
+// and the sizes of the two sets.
+func setSizes[S ~[]E, E comparable](a, b S) (inter, sa, sb int) {
+	ma := make(map[E]bool, len(a))
+	for _, v := range a {
+		ma[v] = true
+	}
+
+	mb := make(map[E]bool, len(b))
+	for _, v := range b {
+		if !mb[v] {
+			mb[v] = true
+			if ma[v] {
+				inter++
+			}
+		}
+	}
+
+	return inter, len(ma), len(mb)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func maxInt(a int, rest ...int) int {
+	for _, v := range rest {
+		if v > a {
+			a = v
+		}
+	}
+	return a
+}
+
+func minInt(a int, rest ...int) int {
+	for _, v := range rest {
+		if v < a {
+			a = v
+		}
+	}
+	return a
+}
@@ -0,0 +1,220 @@
+package slice_test
+
+import (
+	"math/rand"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func TestDamerauLevenshtein(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want int
+	}{
+		{"kitten", "sitting", 3},
+		{"ca", "abc", 2},
+		{"abcd", "acbd", 1},
+		{"abc", "abc", 0},
+		{"", "abc", 3},
+		{"", "", 0},
+	}
+
+	for _, c := range testCases {
+		if r := slice.DamerauLevenshtein(strings.Split(c.a, ""), strings.Split(c.b, "")); r != c.want {
+			t.Errorf("DamerauLevenshtein(%q, %q) = %v, want %v", c.a, c.b, r, c.want)
+		}
+	}
+}
+
+func TestDamerauLevenshteinMax(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		max  int
+		want int
+		ok   bool
+	}{
+		{"kitten", "sitting", 3, 3, true},
+		{"kitten", "sitting", 2, 3, false},
+		{"abcd", "acbd", 1, 1, true},
+		{"abcdef", "a", 2, 3, false},
+	}
+
+	for _, c := range testCases {
+		if r, ok := slice.DamerauLevenshteinMax([]byte(c.a), []byte(c.b), c.max); r != c.want || ok != c.ok {
+			t.Errorf("DamerauLevenshteinMax(%q, %q, %v) = %v, %v, want %v, %v", c.a, c.b, c.max, r, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestDamerauLevenshteinMaxRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	gen := func() []int {
+		s := make([]int, r.Intn(12))
+		for i := range s {
+			s[i] = r.Intn(3)
+		}
+		return s
+	}
+
+	for i := 0; i < 2000; i++ {
+		a, b, max := gen(), gen(), r.Intn(6)
+		want := slice.DamerauLevenshtein(a, b)
+		d, ok := slice.DamerauLevenshteinMax(a, b, max)
+		if want <= max && (d != want || !ok) || want > max && (d != max+1 || ok) {
+			t.Fatalf("DamerauLevenshteinMax(%v, %v, %v) = %v, %v, distance is %v", a, b, max, d, ok, want)
+		}
+	}
+}
+
+func TestDamerauLevenshteinMaxLarge(t *testing.T) {
+	const n = 8000
+
+	a := make([]int, n)
+	for i := range a {
+		a[i] = i
+	}
+	b := slice.Clone(a)
+	b[100], b[101] = b[101], b[100]
+	b[5000] = -1
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	d, ok := slice.DamerauLevenshteinMax(a, b, 2)
+	runtime.ReadMemStats(&after)
+
+	if d != 2 || !ok {
+		t.Errorf("DamerauLevenshteinMax = %v, %v, want 2, true", d, ok)
+	}
+	if alloc := after.TotalAlloc - before.TotalAlloc; alloc > 4<<20 {
+		t.Errorf("DamerauLevenshteinMax allocated %v bytes, want at most %v", alloc, 4<<20)
+	}
+}
+
+func TestDiceSimilarity(t *testing.T) {
+	testCases := []struct {
+		a, b []int
+		want float64
+	}{
+		{[]int{1, 2, 3}, []int{2, 3, 4}, 2.0 / 3},
+		{[]int{1, 1, 2}, []int{1, 2}, 1},
+		{[]int{1}, []int{2}, 0},
+		{nil, nil, 1},
+	}
+
+	for _, c := range testCases {
+		if r := slice.DiceSimilarity(c.a, c.b); r != c.want {
+			t.Errorf("DiceSimilarity(%v, %v) = %v, want %v", c.a, c.b, r, c.want)
+		}
+	}
+}
+
+func TestHamming(t *testing.T) {
+	testCases := []struct {
+		a, b []int
+		want int
+	}{
+		{[]int{1, 2, 3}, []int{1, 5, 3}, 1},
+		{[]int{1, 2, 3}, []int{3, 2, 1}, 2},
+		{[]int{1, 2, 3}, []int{1, 2}, -1},
+		{nil, nil, 0},
+	}
+
+	for _, c := range testCases {
+		if r := slice.Hamming(c.a, c.b); r != c.want {
+			t.Errorf("Hamming(%v, %v) = %v, want %v", c.a, c.b, r, c.want)
+		}
+	}
+}
+
+func TestJaccardSimilarity(t *testing.T) {
+	testCases := []struct {
+		a, b []int
+		want float64
+	}{
+		{[]int{1, 2, 3}, []int{2, 3, 4}, 0.5},
+		{[]int{1, 1, 2}, []int{2, 1}, 1},
+		{[]int{1}, nil, 0},
+		{nil, nil, 1},
+	}
+
+	for _, c := range testCases {
+		if r := slice.JaccardSimilarity(c.a, c.b); r != c.want {
+			t.Errorf("JaccardSimilarity(%v, %v) = %v, want %v", c.a, c.b, r, c.want)
+		}
+	}
+}
+
+func TestLevenshteinDistance(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want int
+	}{
+		{"kitten", "sitting", 3},
+		{"abcd", "acbd", 2},
+		{"flaw", "lawn", 2},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"", "", 0},
+	}
+
+	for _, c := range testCases {
+		if r := slice.LevenshteinDistance([]byte(c.a), []byte(c.b)); r != c.want {
+			t.Errorf("LevenshteinDistance(%q, %q) = %v, want %v", c.a, c.b, r, c.want)
+		}
+	}
+}
+
+func TestLevenshteinDistanceMax(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		max  int
+		want int
+		ok   bool
+	}{
+		{"kitten", "sitting", 3, 3, true},
+		{"kitten", "sitting", 5, 3, true},
+		{"kitten", "sitting", 2, 3, false},
+		{"abcdef", "badcfe", 2, 3, false},
+		{"flaw", "lawn", 2, 2, true},
+		{"abc", "", 3, 3, true},
+		{"", "", 0, 0, true},
+	}
+
+	for _, c := range testCases {
+		if r, ok := slice.LevenshteinDistanceMax([]byte(c.a), []byte(c.b), c.max); r != c.want || ok != c.ok {
+			t.Errorf("LevenshteinDistanceMax(%q, %q, %v) = %v, %v, want %v, %v", c.a, c.b, c.max, r, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestLongestCommonSubsequence(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want int
+	}{
+		{"ABCBDAB", "BDCABA", 4},
+		{"abc", "abc", 3},
+		{"abc", "def", 0},
+		{"", "abc", 0},
+	}
+
+	for _, c := range testCases {
+		r := slice.LongestCommonSubsequence([]byte(c.a), []byte(c.b))
+		if len(r) != c.want || !isSubsequence(r, []byte(c.a)) || !isSubsequence(r, []byte(c.b)) {
+			t.Errorf("LongestCommonSubsequence(%q, %q) = %q, want length %v", c.a, c.b, r, c.want)
+		}
+	}
+}
+
+func isSubsequence[T comparable](sub, s []T) bool {
+	var i int
+	for _, v := range s {
+		if i < len(sub) && sub[i] == v {
+			i++
+		}
+	}
+	return i == len(sub)
+}
@@ -1,5 +1,19 @@
 package slice
 
+import "golang.org/x/exp/constraints"
+
+// ArgMax returns the index of the first largest element in the slice, along with a boolean indicating whether the slice is non-empty.
+// NaN is treated as the extreme value, and the first NaN wins.
+func ArgMax[S ~[]E, E constraints.Ordered](s S) (int, bool) {
+	return argBest(s, nanGreater[E])
+}
+
+// ArgMin returns the index of the first smallest element in the slice, along with a boolean indicating whether the slice is non-empty.
+// NaN is treated as the extreme value, and the first NaN wins.
+func ArgMin[S ~[]E, E constraints.Ordered](s S) (int, bool) {
+	return argBest(s, nanLess[E])
+}
+
 // Count returns the number of elements in the slice that satisfy the given function.
 func Count[S ~[]E, E any](s S, f func(E) bool) int {
 	var c int
@@ -172,7 +186,58 @@ func LastIndexOf[S ~[]E, E comparable](s S, v E) int {
 	return -1
 }
 
+// Max returns the first largest element in the slice, along with a boolean indicating whether the slice is non-empty.
+// NaN is treated as the extreme value, and the first NaN wins.
+func Max[S ~[]E, E constraints.Ordered](s S) (E, bool) {
+	return MaxBy(s, func(v E) E { return v })
+}
+
+// MaxBy returns the first element in the slice with the largest key, along with a boolean indicating whether the slice is non-empty.
+// The key function is called once per element.
+// A NaN key is treated as the extreme key, and the first element with a NaN key wins.
+func MaxBy[S ~[]E, E any, K constraints.Ordered](s S, key func(E) K) (E, bool) {
+	return bestBy(s, key, nanGreater[K])
+}
+
+// Min returns the first smallest element in the slice, along with a boolean indicating whether the slice is non-empty.
+// NaN is treated as the extreme value, and the first NaN wins.
+func Min[S ~[]E, E constraints.Ordered](s S) (E, bool) {
+	return MinBy(s, func(v E) E { return v })
+}
+
+// MinBy returns the first element in the slice with the smallest key, along with a boolean indicating whether the slice is non-empty.
+// The key function is called once per element.
+// A NaN key is treated as the extreme key, and the first element with a NaN key wins.
+func MinBy[S ~[]E, E any, K constraints.Ordered](s S, key func(E) K) (E, bool) {
+	return bestBy(s, key, nanLess[K])
+}
+
+// MinMax returns the first smallest and the first largest elements in the slice, along with a boolean indicating whether the slice is non-empty.
+// NaN is treated as the extreme value in both directions, so if the slice contains NaN both results are the first NaN.
+func MinMax[S ~[]E, E constraints.Ordered](s S) (lo, hi E, ok bool) {
+	if len(s) == 0 {
+		return lo, hi, false
+	}
+
+	lo, hi = s[0], s[0]
+	for _, v := range s {
+		if isNaN(v) {
+			return v, v, true
+		}
+		if v < lo {
+			lo = v
+		} else if v > hi {
+			hi = v
+		}
+	}
+
+	return lo, hi, true
+}
+
 // Most returns the element in the slice that satisfies the given function and is "most" according to that function. The definition of "most" is left up to the caller of this function.
+//
+// Deprecated: Most returns the zero value for an empty slice, which cannot be told apart from a found zero value.
+// Use Min, Max, MinBy, MaxBy, ArgMin or ArgMax instead.
 func Most[S ~[]E, E any](s S, f func(v, most E) bool) E {
 	if len(s) == 0 {
 		var zero E
@@ -197,3 +262,52 @@ func Some[S ~[]E, E any](s S, f func(E) bool) bool {
 
 	return false
 }
+
+// argBest returns the index of the first element of s for which no earlier element is better,
+// along with a boolean indicating whether s is non-empty.
+func argBest[S ~[]E, E any](s S, better func(a, b E) bool) (int, bool) {
+	if len(s) == 0 {
+		return -1, false
+	}
+
+	var best int
+	for i := 1; i < len(s); i++ {
+		if better(s[i], s[best]) {
+			best = i
+		}
+	}
+
+	return best, true
+}
+
+// isNaN reports whether v is a floating-point NaN, the only value not equal to itself.
+func isNaN[E constraints.Ordered](v E) bool {
+	return v != v
+}
+
+// nanGreater reports whether a orders after b, treating NaN as greater than every other value.
+func nanGreater[E constraints.Ordered](a, b E) bool {
+	return a > b || isNaN(a) && !isNaN(b)
+}
+
+// nanLess reports whether a orders before b, treating NaN as less than every other value.
+func nanLess[E constraints.Ordered](a, b E) bool {
+	return a < b || isNaN(a) && !isNaN(b)
+}
+
+// bestBy returns the first element of s with the best key, computing each key once.
+func bestBy[S ~[]E, E any, K any](s S, key func(E) K, better func(a, b K) bool) (E, bool) {
+	if len(s) == 0 {
+		var zero E
+		return zero, false
+	}
+
+	best, bestKey := s[0], key(s[0])
+	for _, v := range s[1:] {
+		if k := key(v); better(k, bestKey) {
+			best, bestKey = v, k
+		}
+	}
+
+	return best, true
+}
@@ -1,11 +1,48 @@
 package slice_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/kim89098/slice"
 )
 
+func TestArgMax(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		want int
+		ok   bool
+	}{
+		{[]int{1, 5, 3, 5}, 1, true},
+		{[]int{-1}, 0, true},
+		{nil, -1, false},
+	}
+
+	for _, c := range testCases {
+		if r, ok := slice.ArgMax(c.s); r != c.want || ok != c.ok {
+			t.Errorf("ArgMax(%v) = %v, %v, want %v, %v", c.s, r, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestArgMin(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		want int
+		ok   bool
+	}{
+		{[]int{3, 1, 2, 1}, 1, true},
+		{[]int{0}, 0, true},
+		{nil, -1, false},
+	}
+
+	for _, c := range testCases {
+		if r, ok := slice.ArgMin(c.s); r != c.want || ok != c.ok {
+			t.Errorf("ArgMin(%v) = %v, %v, want %v, %v", c.s, r, ok, c.want, c.ok)
+		}
+	}
+}
+
 func TestCount(t *testing.T) {
 	testCases := []struct {
 		s    []int
@@ -289,6 +326,136 @@ func TestLastIndexOf(t *testing.T) {
 	}
 }
 
+func TestMax(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		want int
+		ok   bool
+	}{
+		{[]int{1, 5, 3}, 5, true},
+		{[]int{-3, -1, -2}, -1, true},
+		{[]int{}, 0, false},
+		{nil, 0, false},
+	}
+
+	for _, c := range testCases {
+		if r, ok := slice.Max(c.s); r != c.want || ok != c.ok {
+			t.Errorf("Max(%v) = %v, %v, want %v, %v", c.s, r, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestMaxBy(t *testing.T) {
+	testCases := []struct {
+		s    []string
+		want string
+		ok   bool
+	}{
+		{[]string{"a", "ccc", "bbb"}, "ccc", true},
+		{nil, "", false},
+	}
+
+	for _, c := range testCases {
+		if r, ok := slice.MaxBy(c.s, func(v string) int { return len(v) }); r != c.want || ok != c.ok {
+			t.Errorf("MaxBy(%v) = %v, %v, want %v, %v", c.s, r, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestMin(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		want int
+		ok   bool
+	}{
+		{[]int{3, 0, 5}, 0, true},
+		{[]int{2}, 2, true},
+		{nil, 0, false},
+	}
+
+	for _, c := range testCases {
+		if r, ok := slice.Min(c.s); r != c.want || ok != c.ok {
+			t.Errorf("Min(%v) = %v, %v, want %v, %v", c.s, r, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestMinBy(t *testing.T) {
+	testCases := []struct {
+		s    []string
+		want string
+		ok   bool
+	}{
+		{[]string{"bb", "a", "c"}, "a", true},
+		{nil, "", false},
+	}
+
+	for _, c := range testCases {
+		if r, ok := slice.MinBy(c.s, func(v string) int { return len(v) }); r != c.want || ok != c.ok {
+			t.Errorf("MinBy(%v) = %v, %v, want %v, %v", c.s, r, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	testCases := []struct {
+		s        []int
+		min, max int
+		ok       bool
+	}{
+		{[]int{3, 1, 4, 1, 5}, 1, 5, true},
+		{[]int{7}, 7, 7, true},
+		{nil, 0, 0, false},
+	}
+
+	for _, c := range testCases {
+		if min, max, ok := slice.MinMax(c.s); min != c.min || max != c.max || ok != c.ok {
+			t.Errorf("MinMax(%v) = %v, %v, %v, want %v, %v, %v", c.s, min, max, ok, c.min, c.max, c.ok)
+		}
+	}
+}
+
+func TestMinMaxNaN(t *testing.T) {
+	nan := math.NaN()
+	testCases := [][]float64{
+		{nan, 1, 2},
+		{1, nan, 2},
+		{1, 2, nan},
+		{2, nan, nan, 1},
+	}
+
+	for _, s := range testCases {
+		first := slice.FindIndex(s, math.IsNaN)
+		if r, ok := slice.Max(s); !math.IsNaN(r) || !ok {
+			t.Errorf("Max(%v) = %v, %v, want NaN, true", s, r, ok)
+		}
+		if r, ok := slice.Min(s); !math.IsNaN(r) || !ok {
+			t.Errorf("Min(%v) = %v, %v, want NaN, true", s, r, ok)
+		}
+		if lo, hi, ok := slice.MinMax(s); !math.IsNaN(lo) || !math.IsNaN(hi) || !ok {
+			t.Errorf("MinMax(%v) = %v, %v, %v, want NaN, NaN, true", s, lo, hi, ok)
+		}
+		if r, ok := slice.ArgMax(s); r != first || !ok {
+			t.Errorf("ArgMax(%v) = %v, %v, want %v, true", s, r, ok, first)
+		}
+		if r, ok := slice.ArgMin(s); r != first || !ok {
+			t.Errorf("ArgMin(%v) = %v, %v, want %v, true", s, r, ok, first)
+		}
+	}
+
+	points := []struct{ x float64 }{{1}, {nan}, {3}}
+	if r, ok := slice.MaxBy(points, func(p struct{ x float64 }) float64 { return p.x }); !math.IsNaN(r.x) || !ok {
+		t.Errorf("MaxBy(%v) = %v, %v, want the NaN element", points, r, ok)
+	}
+	if r, ok := slice.MinBy(points, func(p struct{ x float64 }) float64 { return p.x }); !math.IsNaN(r.x) || !ok {
+		t.Errorf("MinBy(%v) = %v, %v, want the NaN element", points, r, ok)
+	}
+
+	if lo, hi, ok := slice.MinMax([]float64{3, -1, math.Inf(1), 2}); lo != -1 || !math.IsInf(hi, 1) || !ok {
+		t.Errorf("MinMax = %v, %v, %v, want -1, +Inf, true", lo, hi, ok)
+	}
+}
+
 func TestMost(t *testing.T) {
 	testCases := []struct {
 		s    []int
@@ -0,0 +1,262 @@
+package slice
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"unsafe"
+)
+
+const (
+	// defaultMemoryBudget is the memory budget used by ExternalSorter when none is set.
+	defaultMemoryBudget = 64 << 20
+
+	// defaultMaxOpenRuns is the merge fan-in used by ExternalSorter when none is set.
+	defaultMaxOpenRuns = 128
+)
+
+// ExternalSorter sorts streams of elements that may not fit in memory. Elements are collected into
+// runs of at most MemoryBudget bytes, each run is sorted with Sort and spilled to a temporary file,
+// and the runs are then merged with a k-way heap merge. If there are more than MaxOpenRuns runs,
+// they are first merged in groups into longer intermediate runs, so the number of open files stays
+// bounded however large the input is. Inputs that fit in a single run are sorted in memory without
+// touching the disk.
+type ExternalSorter[E any] struct {
+	// Less defines the sort order. It is required.
+	Less func(a, b E) bool
+
+	// NewEncoder returns a function that writes elements to w. It is required.
+	NewEncoder func(w io.Writer) func(E) error
+
+	// NewDecoder returns a function that reads elements written by the encoder from r,
+	// and returns io.EOF once r is exhausted. It is required.
+	NewDecoder func(r io.Reader) func() (E, error)
+
+	// MemoryBudget is the approximate number of bytes of elements held in memory at once.
+	// If zero, 64 MiB is used.
+	MemoryBudget int
+
+	// SizeOf returns the approximate in-memory size of an element in bytes.
+	// If nil, the static size of E is used.
+	SizeOf func(E) int
+
+	// TempDir is the directory for the run files. If empty, os.TempDir() is used.
+	TempDir string
+
+	// MaxOpenRuns is the maximum number of run files merged at once. An intermediate merge
+	// also holds its output file open. If zero, 128 is used; values below 2 are treated as 2.
+	MaxOpenRuns int
+}
+
+// Sort reads elements from next until it returns io.EOF, and passes them to emit in sorted order.
+// The sort is not stable. Temporary files are removed before Sort returns.
+func (es *ExternalSorter[E]) Sort(next func() (E, error), emit func(E) error) (err error) {
+	if es.Less == nil || es.NewEncoder == nil || es.NewDecoder == nil {
+		return errors.New("slice: ExternalSorter requires Less, NewEncoder and NewDecoder")
+	}
+
+	budget := es.MemoryBudget
+	if budget <= 0 {
+		budget = defaultMemoryBudget
+	}
+	sizeOf := es.SizeOf
+	if sizeOf == nil {
+		var zero E
+		size := int(unsafe.Sizeof(zero))
+		sizeOf = func(E) int { return size }
+	}
+
+	maxOpen := es.MaxOpenRuns
+	if maxOpen == 0 {
+		maxOpen = defaultMaxOpenRuns
+	}
+	maxOpen = maxInt(maxOpen, 2)
+
+	// runs holds the names of the run files that have not been merged yet.
+	var runs []string
+	defer func() {
+		for _, name := range runs {
+			if rerr := os.Remove(name); rerr != nil && err == nil {
+				err = fmt.Errorf("slice: removing run file: %w", rerr)
+			}
+		}
+	}()
+
+	var run []E
+	var used int
+
+	for {
+		v, err := next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		run = append(run, v)
+		if used += sizeOf(v); used >= budget {
+			if err := es.spill(run, &runs); err != nil {
+				return err
+			}
+			run, used = run[:0], 0
+		}
+	}
+
+	if len(runs) == 0 {
+		Sort(run, es.Less)
+		for _, v := range run {
+			if err := emit(v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(run) > 0 {
+		if err := es.spill(run, &runs); err != nil {
+			return err
+		}
+	}
+	run = nil
+
+	for len(runs) > maxOpen {
+		if err := es.mergePass(&runs, maxOpen); err != nil {
+			return err
+		}
+	}
+	return es.merge(runs, emit)
+}
+
+// spill sorts run and writes it to a new run file, whose name is appended to runs.
+func (es *ExternalSorter[E]) spill(run []E, runs *[]string) error {
+	Sort(run, es.Less)
+
+	return es.writeRun(runs, func(encode func(E) error) error {
+		for _, v := range run {
+			if err := encode(v); err != nil {
+				return fmt.Errorf("slice: writing run file: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// writeRun creates a new run file, appends its name to runs, and calls write with an encoder for it.
+// The file is closed before writeRun returns.
+func (es *ExternalSorter[E]) writeRun(runs *[]string, write func(encode func(E) error) error) error {
+	f, err := os.CreateTemp(es.TempDir, "slice-sort-*")
+	if err != nil {
+		return fmt.Errorf("slice: creating run file: %w", err)
+	}
+	*runs = append(*runs, f.Name())
+
+	w := bufio.NewWriter(f)
+	if err := write(es.NewEncoder(w)); err != nil {
+		f.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return fmt.Errorf("slice: writing run file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("slice: writing run file: %w", err)
+	}
+	return nil
+}
+
+// mergePass merges the runs in groups of maxOpen into new, longer runs, keeping their order,
+// and removes the merged run files.
+func (es *ExternalSorter[E]) mergePass(runs *[]string, maxOpen int) error {
+	pending := *runs
+	*runs = nil
+
+	for len(pending) > 0 {
+		group := pending[:minInt(maxOpen, len(pending))]
+
+		err := es.writeRun(runs, func(encode func(E) error) error {
+			return es.merge(group, func(v E) error {
+				if err := encode(v); err != nil {
+					return fmt.Errorf("slice: writing run file: %w", err)
+				}
+				return nil
+			})
+		})
+		for _, name := range group {
+			if rerr := os.Remove(name); rerr != nil && err == nil {
+				err = fmt.Errorf("slice: removing run file: %w", rerr)
+			}
+		}
+		pending = pending[len(group):]
+
+		if err != nil {
+			*runs = append(*runs, pending...)
+			return err
+		}
+	}
+
+	return nil
+}
+
+type runHead[E any] struct {
+	v   E
+	run int
+}
+
+// merge merges the sorted run files into emit with a heap holding the head of each run.
+// Ties are broken by run order.
+func (es *ExternalSorter[E]) merge(runs []string, emit func(E) error) error {
+	decoders := make([]func() (E, error), len(runs))
+	h := make([]runHead[E], 0, len(runs))
+	less := func(a, b runHead[E]) bool {
+		if es.Less(a.v, b.v) {
+			return true
+		}
+		if es.Less(b.v, a.v) {
+			return false
+		}
+		return a.run < b.run
+	}
+
+	for i, name := range runs {
+		f, err := os.Open(name)
+		if err != nil {
+			return fmt.Errorf("slice: opening run file: %w", err)
+		}
+		defer f.Close()
+
+		decoders[i] = es.NewDecoder(bufio.NewReader(f))
+		v, err := decoders[i]()
+		if err == io.EOF {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("slice: reading run file: %w", err)
+		}
+		h = append(h, runHead[E]{v, i})
+		siftUp(h, len(h)-1, less)
+	}
+
+	for len(h) > 0 {
+		if err := emit(h[0].v); err != nil {
+			return err
+		}
+
+		v, err := decoders[h[0].run]()
+		switch {
+		case err == io.EOF:
+			h[0] = h[len(h)-1]
+			h = h[:len(h)-1]
+		case err != nil:
+			return fmt.Errorf("slice: reading run file: %w", err)
+		default:
+			h[0].v = v
+		}
+		siftDown(h, 0, less)
+	}
+
+	return nil
+}
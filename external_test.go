@@ -0,0 +1,134 @@
+package slice_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func int64Sorter(dir string, budget int) *slice.ExternalSorter[int64] {
+	return &slice.ExternalSorter[int64]{
+		Less: func(a, b int64) bool { return a < b },
+		NewEncoder: func(w io.Writer) func(int64) error {
+			return func(v int64) error { return binary.Write(w, binary.LittleEndian, v) }
+		},
+		NewDecoder: func(r io.Reader) func() (int64, error) {
+			return func() (int64, error) {
+				var v int64
+				err := binary.Read(r, binary.LittleEndian, &v)
+				return v, err
+			}
+		},
+		MemoryBudget: budget,
+		TempDir:      dir,
+	}
+}
+
+func sliceSource[T any](s []T) func() (T, error) {
+	return func() (T, error) {
+		var v T
+		if len(s) == 0 {
+			return v, io.EOF
+		}
+		v, s = s[0], s[1:]
+		return v, nil
+	}
+}
+
+func TestExternalSorterSort(t *testing.T) {
+	for _, n := range []int{0, 10, 10000} {
+		for _, budget := range []int{8 * 100, 0} {
+			dir := t.TempDir()
+			in := slice.Map(randomInts(n, 1000), func(v int) int64 { return int64(v) })
+
+			var out []int64
+			err := int64Sorter(dir, budget).Sort(sliceSource(in), func(v int64) error {
+				out = append(out, v)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("Sort(n=%v, budget=%v) returned error %v", n, budget, err)
+			}
+
+			if !slice.IsSorted(out) || !slice.EqualsAnyOrder(out, in) {
+				t.Errorf("Sort(n=%v, budget=%v) did not sort the input", n, budget)
+			}
+			if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+				t.Errorf("Sort(n=%v, budget=%v) left %v temporary files", n, budget, len(entries))
+			}
+		}
+	}
+}
+
+func TestExternalSorterMaxOpenRuns(t *testing.T) {
+	openFiles := func() int {
+		entries, err := os.ReadDir("/proc/self/fd")
+		if err != nil {
+			t.Skip("cannot count open files:", err)
+		}
+		return len(entries)
+	}
+
+	const maxOpen = 4
+	dir := t.TempDir()
+	in := slice.Map(randomInts(10000, 1000), func(v int) int64 { return int64(v) })
+
+	// A budget of 10 elements gives 1000 runs, which takes several passes to merge.
+	es := int64Sorter(dir, 8*10)
+	es.MaxOpenRuns = maxOpen
+
+	base := openFiles()
+	var peak, decoders int
+	newDecoder := es.NewDecoder
+	es.NewDecoder = func(r io.Reader) func() (int64, error) {
+		decoders++
+		if n := openFiles() - base; n > peak {
+			peak = n
+		}
+		return newDecoder(r)
+	}
+
+	var out []int64
+	err := es.Sort(sliceSource(in), func(v int64) error {
+		out = append(out, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Sort returned error %v", err)
+	}
+
+	if !slice.IsSorted(out) || !slice.EqualsAnyOrder(out, in) {
+		t.Errorf("Sort did not sort the input")
+	}
+	if decoders <= 1000 {
+		t.Errorf("Sort opened %v runs, want intermediate merges of the 1000 spilled runs", decoders)
+	}
+	if peak > maxOpen+1 {
+		t.Errorf("Sort held %v files open, want at most %v", peak, maxOpen+1)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("Sort left %v temporary files", len(entries))
+	}
+}
+
+func TestExternalSorterSortError(t *testing.T) {
+	errStop := errors.New("stop")
+	dir := t.TempDir()
+	in := []int64{5, 4, 3, 2, 1}
+
+	err := int64Sorter(dir, 16).Sort(sliceSource(in), func(int64) error { return errStop })
+	if !errors.Is(err, errStop) {
+		t.Errorf("Sort returned error %v, want %v", err, errStop)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("Sort left %v temporary files", len(entries))
+	}
+
+	if err := (&slice.ExternalSorter[int64]{}).Sort(sliceSource(in), nil); err == nil {
+		t.Errorf("Sort without configuration returned no error")
+	}
+}
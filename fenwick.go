@@ -0,0 +1,70 @@
+package slice
+
+// FenwickTree is a binary indexed tree over a sequence of numbers. It supports adding to an element
+// and querying prefix and range sums in O(log n) time.
+type FenwickTree[T Number] struct {
+	tree []T
+}
+
+// NewFenwickTree returns a FenwickTree over n zero values.
+func NewFenwickTree[T Number](n int) *FenwickTree[T] {
+	return &FenwickTree[T]{make([]T, n+1)}
+}
+
+// NewFenwickTreeFrom returns a FenwickTree over the elements of s, built in O(len(s)) time.
+func NewFenwickTreeFrom[S ~[]T, T Number](s S) *FenwickTree[T] {
+	tree := make([]T, len(s)+1)
+	copy(tree[1:], s)
+
+	for i := 1; i < len(tree); i++ {
+		if p := i + i&-i; p < len(tree) {
+			tree[p] += tree[i]
+		}
+	}
+
+	return &FenwickTree[T]{tree}
+}
+
+// Add adds delta to the element at index i.
+func (ft *FenwickTree[T]) Add(i int, delta T) {
+	if i < 0 || i >= ft.Len() {
+		panic("slice: FenwickTree index out of range")
+	}
+
+	for i++; i < len(ft.tree); i += i & -i {
+		ft.tree[i] += delta
+	}
+}
+
+// Get returns the element at index i.
+func (ft *FenwickTree[T]) Get(i int) T {
+	return ft.RangeSum(i, i+1)
+}
+
+// Len returns the number of elements.
+func (ft *FenwickTree[T]) Len() int {
+	return len(ft.tree) - 1
+}
+
+// PrefixSum returns the sum of the elements in the range [0:i).
+func (ft *FenwickTree[T]) PrefixSum(i int) T {
+	if i < 0 || i > ft.Len() {
+		panic("slice: FenwickTree index out of range")
+	}
+
+	var sum T
+	for ; i > 0; i -= i & -i {
+		sum += ft.tree[i]
+	}
+	return sum
+}
+
+// RangeSum returns the sum of the elements in the range [lo:hi).
+func (ft *FenwickTree[T]) RangeSum(lo, hi int) T {
+	return ft.PrefixSum(hi) - ft.PrefixSum(lo)
+}
+
+// Set sets the element at index i to v.
+func (ft *FenwickTree[T]) Set(i int, v T) {
+	ft.Add(i, v-ft.Get(i))
+}
@@ -0,0 +1,54 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func TestFenwickTree(t *testing.T) {
+	s := randomInts(100, 50)
+	ft := slice.NewFenwickTreeFrom(s)
+
+	check := func() {
+		t.Helper()
+		for lo := 0; lo <= len(s); lo += 7 {
+			for hi := lo; hi <= len(s); hi += 5 {
+				if r, want := ft.RangeSum(lo, hi), slice.Sum(s[lo:hi]); r != want {
+					t.Fatalf("RangeSum(%v, %v) = %v, want %v", lo, hi, r, want)
+				}
+			}
+		}
+	}
+
+	if ft.Len() != len(s) {
+		t.Errorf("Len() = %v, want %v", ft.Len(), len(s))
+	}
+	check()
+
+	ft.Add(10, 5)
+	s[10] += 5
+	ft.Set(42, -3)
+	s[42] = -3
+	check()
+
+	if r := ft.Get(42); r != -3 {
+		t.Errorf("Get(42) = %v, want -3", r)
+	}
+	if r, want := ft.PrefixSum(len(s)), slice.Sum(s); r != want {
+		t.Errorf("PrefixSum(%v) = %v, want %v", len(s), r, want)
+	}
+}
+
+func TestNewFenwickTree(t *testing.T) {
+	ft := slice.NewFenwickTree[float64](4)
+	ft.Add(0, 1.5)
+	ft.Add(3, 2)
+
+	if r := ft.PrefixSum(4); r != 3.5 {
+		t.Errorf("PrefixSum(4) = %v, want 3.5", r)
+	}
+	if r := ft.RangeSum(1, 3); r != 0 {
+		t.Errorf("RangeSum(1, 3) = %v, want 0", r)
+	}
+}
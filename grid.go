@@ -0,0 +1,154 @@
+package slice
+
+// The functions in this file treat a 2D slice as a rectangular grid whose width is the length of its
+// first row. Functions that return a new grid allocate it with a contiguous backing array, as Make2D does.
+
+// Column returns a copy of column j of the grid ss.
+func Column[SS ~[]S, S ~[]E, E any](ss SS, j int) S {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	c := make(S, len(ss))
+	for i, s := range ss {
+		c[i] = s[j]
+	}
+	return c
+}
+
+// Flat2D returns the cells of the grid ss in row-major order, the reverse of Reshape.
+// Unlike Flat, it returns ErrLengthMismatch if the rows of ss do not all have the same length.
+func Flat2D[SS ~[]S, S ~[]E, E any](ss SS) (S, error) {
+	m, n := gridShape(ss)
+	if !Every(ss, func(s S) bool { return len(s) == n }) {
+		return nil, ErrLengthMismatch
+	}
+	if m*n == 0 {
+		return nil, nil
+	}
+
+	r := make(S, 0, m*n)
+	for _, s := range ss {
+		r = append(r, s...)
+	}
+	return r, nil
+}
+
+// FlipH reverses the order of the columns of the grid ss in place.
+func FlipH[SS ~[]S, S ~[]E, E any](ss SS) {
+	for _, s := range ss {
+		Reverse(s)
+	}
+}
+
+// FlipV reverses the order of the rows of the grid ss in place.
+func FlipV[SS ~[]S, S ~[]E, E any](ss SS) {
+	Reverse(ss)
+}
+
+// Reshape returns s as a grid of m rows and n columns whose rows are views into s, in row-major order.
+// It returns ErrLengthMismatch if m*n != len(s). Flat2D performs the reverse operation.
+func Reshape[S ~[]E, E any](s S, m, n int) ([]S, error) {
+	if m < 0 || n < 0 || m*n != len(s) {
+		return nil, ErrLengthMismatch
+	}
+	if m == 0 {
+		return nil, nil
+	}
+
+	ss := make([]S, m)
+	for i := range ss {
+		ss[i] = s[i*n : (i+1)*n : (i+1)*n]
+	}
+	return ss, nil
+}
+
+// Resize2D returns a new grid of m rows and n columns holding the overlapping part of the grid ss.
+// Unlike Expand2D, it can also shrink the grid. New cells are zero values.
+func Resize2D[SS ~[]S, S ~[]E, E any](ss SS, m, n int) SS {
+	r := make2D[SS](m, n)
+	for i := 0; i < m && i < len(ss); i++ {
+		copy(r[i], ss[i])
+	}
+	return r
+}
+
+// Rotate90 returns a new grid holding the grid ss rotated 90 degrees clockwise.
+func Rotate90[SS ~[]S, S ~[]E, E any](ss SS) SS {
+	m, n := gridShape(ss)
+	r := make2D[SS](n, m)
+	for i, s := range ss {
+		for j, v := range s[:n] {
+			r[j][m-1-i] = v
+		}
+	}
+	return r
+}
+
+// Rotate180 returns a new grid holding the grid ss rotated 180 degrees.
+func Rotate180[SS ~[]S, S ~[]E, E any](ss SS) SS {
+	m, n := gridShape(ss)
+	r := make2D[SS](m, n)
+	for i, s := range ss {
+		for j, v := range s[:n] {
+			r[m-1-i][n-1-j] = v
+		}
+	}
+	return r
+}
+
+// Rotate270 returns a new grid holding the grid ss rotated 90 degrees counterclockwise.
+func Rotate270[SS ~[]S, S ~[]E, E any](ss SS) SS {
+	m, n := gridShape(ss)
+	r := make2D[SS](n, m)
+	for i, s := range ss {
+		for j, v := range s[:n] {
+			r[n-1-j][i] = v
+		}
+	}
+	return r
+}
+
+// SubGrid returns the rows [r0:r1) and columns [c0:c1) of the grid ss as a view:
+// the returned rows share their elements with ss.
+func SubGrid[SS ~[]S, S ~[]E, E any](ss SS, r0, c0, r1, c1 int) SS {
+	r := make(SS, r1-r0)
+	for i, s := range ss[r0:r1] {
+		r[i] = s[c0:c1:c1]
+	}
+	return r
+}
+
+// Transpose2D returns a new grid holding the transpose of the grid ss.
+func Transpose2D[SS ~[]S, S ~[]E, E any](ss SS) SS {
+	m, n := gridShape(ss)
+	r := make2D[SS](n, m)
+	for i, s := range ss {
+		for j, v := range s[:n] {
+			r[j][i] = v
+		}
+	}
+	return r
+}
+
+// gridShape returns the number of rows and columns of the grid ss.
+func gridShape[SS ~[]S, S ~[]E, E any](ss SS) (m, n int) {
+	if len(ss) == 0 {
+		return 0, 0
+	}
+	return len(ss), len(ss[0])
+}
+
+// make2D is like Make2D, but returns a grid of the given 2D slice type.
+func make2D[SS ~[]S, S ~[]E, E any](m, n int) SS {
+	if m == 0 {
+		return nil
+	}
+
+	mat := make(SS, m)
+	mem := make(S, m*n)
+	for i := range mat {
+		mat[i] = mem[i*n : (i+1)*n : (i+1)*n]
+	}
+	return mat
+}
@@ -0,0 +1,135 @@
+package slice_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func TestColumn(t *testing.T) {
+	ss := [][]int{{1, 2}, {3, 4}, {5, 6}}
+	if r, want := slice.Column(ss, 1), []int{2, 4, 6}; !slice.Equals(r, want) {
+		t.Errorf("Column(%v, 1) = %v, want %v", ss, r, want)
+	}
+	if r := slice.Column([][]int(nil), 0); r != nil {
+		t.Errorf("Column(nil, 0) = %v, want nil", r)
+	}
+}
+
+func TestFlat2D(t *testing.T) {
+	testCases := []struct {
+		ss   [][]int
+		want []int
+		err  error
+	}{
+		{[][]int{{1, 2, 3}, {4, 5, 6}}, []int{1, 2, 3, 4, 5, 6}, nil},
+		{[][]int{{1}, {2}}, []int{1, 2}, nil},
+		{[][]int{{1, 2}, {3}}, nil, slice.ErrLengthMismatch},
+		{[][]int{{}, {}}, nil, nil},
+		{nil, nil, nil},
+	}
+
+	for _, c := range testCases {
+		if r, err := slice.Flat2D(c.ss); !slice.Equals(r, c.want) || !errors.Is(err, c.err) {
+			t.Errorf("Flat2D(%v) = %v, %v, want %v, %v", c.ss, r, err, c.want, c.err)
+		}
+	}
+}
+
+func TestFlipH(t *testing.T) {
+	ss := [][]int{{1, 2, 3}, {4, 5, 6}}
+	want := [][]int{{3, 2, 1}, {6, 5, 4}}
+	if slice.FlipH(ss); !equals2D(ss, want) {
+		t.Errorf("got %v, want %v", ss, want)
+	}
+}
+
+func TestFlipV(t *testing.T) {
+	ss := [][]int{{1, 2}, {3, 4}, {5, 6}}
+	want := [][]int{{5, 6}, {3, 4}, {1, 2}}
+	if slice.FlipV(ss); !equals2D(ss, want) {
+		t.Errorf("got %v, want %v", ss, want)
+	}
+}
+
+func TestReshape(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6}
+
+	r, err := slice.Reshape(s, 2, 3)
+	if want := [][]int{{1, 2, 3}, {4, 5, 6}}; err != nil || !equals2D(r, want) {
+		t.Errorf("Reshape(%v, 2, 3) = %v, %v, want %v", s, r, err, want)
+	}
+	if f, err := slice.Flat2D(r); err != nil || !slice.Equals(f, s) {
+		t.Errorf("Flat2D(Reshape(%v, 2, 3)) = %v, %v", s, f, err)
+	}
+
+	r[1][0] = 9
+	if s[3] != 9 {
+		t.Errorf("Reshape rows are not views into the input")
+	}
+
+	if _, err := slice.Reshape(s, 4, 2); !errors.Is(err, slice.ErrLengthMismatch) {
+		t.Errorf("Reshape(%v, 4, 2) returned error %v", s, err)
+	}
+}
+
+func TestResize2D(t *testing.T) {
+	ss := [][]int{{1, 2, 3}, {4, 5, 6}}
+
+	testCases := []struct {
+		m, n int
+		want [][]int
+	}{
+		{1, 2, [][]int{{1, 2}}},
+		{3, 4, [][]int{{1, 2, 3, 0}, {4, 5, 6, 0}, {0, 0, 0, 0}}},
+		{0, 2, nil},
+	}
+
+	for _, c := range testCases {
+		if r := slice.Resize2D(ss, c.m, c.n); !equals2D(r, c.want) {
+			t.Errorf("Resize2D(%v, %v, %v) = %v, want %v", ss, c.m, c.n, r, c.want)
+		}
+	}
+}
+
+func TestRotate(t *testing.T) {
+	ss := [][]int{{1, 2, 3}, {4, 5, 6}}
+
+	if r, want := slice.Rotate90(ss), [][]int{{4, 1}, {5, 2}, {6, 3}}; !equals2D(r, want) {
+		t.Errorf("Rotate90(%v) = %v, want %v", ss, r, want)
+	}
+	if r, want := slice.Rotate180(ss), [][]int{{6, 5, 4}, {3, 2, 1}}; !equals2D(r, want) {
+		t.Errorf("Rotate180(%v) = %v, want %v", ss, r, want)
+	}
+	if r, want := slice.Rotate270(ss), [][]int{{3, 6}, {2, 5}, {1, 4}}; !equals2D(r, want) {
+		t.Errorf("Rotate270(%v) = %v, want %v", ss, r, want)
+	}
+	if r := slice.Rotate90(slice.Rotate270(ss)); !equals2D(r, ss) {
+		t.Errorf("Rotate90(Rotate270(%v)) = %v", ss, r)
+	}
+	if r := slice.Rotate90([][]int(nil)); r != nil {
+		t.Errorf("Rotate90(nil) = %v, want nil", r)
+	}
+}
+
+func TestSubGrid(t *testing.T) {
+	ss := [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+	r := slice.SubGrid(ss, 1, 1, 3, 3)
+
+	if want := [][]int{{5, 6}, {8, 9}}; !equals2D(r, want) {
+		t.Errorf("SubGrid = %v, want %v", r, want)
+	}
+
+	r[0][0] = 0
+	if ss[1][1] != 0 {
+		t.Errorf("SubGrid is not a view into the grid")
+	}
+}
+
+func TestTranspose2D(t *testing.T) {
+	ss := [][]int{{1, 2, 3}, {4, 5, 6}}
+	if r, want := slice.Transpose2D(ss), [][]int{{1, 4}, {2, 5}, {3, 6}}; !equals2D(r, want) {
+		t.Errorf("Transpose2D(%v) = %v, want %v", ss, r, want)
+	}
+}
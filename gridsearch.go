@@ -0,0 +1,224 @@
+package slice
+
+// Point is the position of a cell in a grid.
+type Point struct {
+	Row, Col int
+}
+
+// Neighborhood defines which cells of a grid are adjacent.
+type Neighborhood struct {
+	// Diagonal selects 8-connectivity, in which diagonal cells are adjacent, instead of 4-connectivity.
+	Diagonal bool
+	// Wrap makes the grid wrap around at its edges, so that the first and last rows and columns are adjacent.
+	Wrap bool
+}
+
+var (
+	orthogonalSteps = []Point{{-1, 0}, {0, -1}, {0, 1}, {1, 0}}
+	diagonalSteps   = []Point{{-1, -1}, {-1, 0}, {-1, 1}, {0, -1}, {0, 1}, {1, -1}, {1, 0}, {1, 1}}
+)
+
+// AStar returns a shortest path from start to goal through passable cells of the grid ss using the
+// A* search, where every step costs 1. heuristic estimates the number of steps from a cell to the goal;
+// the path is shortest if it never overestimates. The path includes start and goal.
+// It returns nil and false if there is no path.
+func AStar[SS ~[]S, S ~[]E, E any](ss SS, start, goal Point, nb Neighborhood, passable func(E) bool, heuristic func(p, goal Point) int) ([]Point, bool) {
+	m, n := gridShape(ss)
+	if !inGrid(start, m, n) || !inGrid(goal, m, n) || !passable(ss[start.Row][start.Col]) || !passable(ss[goal.Row][goal.Col]) {
+		return nil, false
+	}
+
+	type node struct {
+		p    Point
+		f, g int
+	}
+	less := func(a, b node) bool { return a.f < b.f || (a.f == b.f && a.g > b.g) }
+
+	dist := make([]int, m*n)
+	Fill(dist, -1)
+	prev := make([]int, m*n)
+	dist[start.Row*n+start.Col] = 0
+
+	h := []node{{start, heuristic(start, goal), 0}}
+	for len(h) > 0 {
+		cur := h[0]
+		h[0] = h[len(h)-1]
+		h = h[:len(h)-1]
+		siftDown(h, 0, less)
+
+		if cur.p == goal {
+			return tracePath(prev, start, goal, n), true
+		}
+		if cur.g > dist[cur.p.Row*n+cur.p.Col] {
+			continue
+		}
+
+		ForEachNeighbor(ss, cur.p, nb, func(q Point) {
+			i := q.Row*n + q.Col
+			if g := cur.g + 1; passable(ss[q.Row][q.Col]) && (dist[i] < 0 || g < dist[i]) {
+				dist[i], prev[i] = g, cur.p.Row*n+cur.p.Col
+				h = append(h, node{q, g + heuristic(q, goal), g})
+				siftUp(h, len(h)-1, less)
+			}
+		})
+	}
+
+	return nil, false
+}
+
+// Chebyshev returns the Chebyshev distance between a and b, a heuristic for AStar with 8-connectivity.
+func Chebyshev(a, b Point) int {
+	return maxInt(abs(a.Row-b.Row), abs(a.Col-b.Col))
+}
+
+// ConnectedComponents labels the connected regions of equal cells of the grid ss. It returns a grid
+// of the same shape holding the label of each cell, numbered from 0 in row-major order of the
+// regions' first cells, and the number of regions.
+func ConnectedComponents[SS ~[]S, S ~[]E, E comparable](ss SS, nb Neighborhood) ([][]int, int) {
+	m, n := gridShape(ss)
+	labels := make2D[[][]int](m, n)
+	for _, row := range labels {
+		Fill(row, -1)
+	}
+
+	var count int
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			if labels[i][j] >= 0 {
+				continue
+			}
+
+			v := ss[i][j]
+			bfs(ss, Point{i, j}, nb, func(q Point) bool {
+				return labels[q.Row][q.Col] < 0 && ss[q.Row][q.Col] == v
+			}, func(q, _ Point) {
+				labels[q.Row][q.Col] = count
+			})
+			count++
+		}
+	}
+
+	return labels, count
+}
+
+// FloodFill sets every cell of the region of the grid ss that is connected to start and equal to it
+// to v, and returns the positions of the filled cells in breadth-first order.
+func FloodFill[SS ~[]S, S ~[]E, E comparable](ss SS, start Point, v E, nb Neighborhood) []Point {
+	m, n := gridShape(ss)
+	if !inGrid(start, m, n) {
+		return nil
+	}
+
+	old := ss[start.Row][start.Col]
+	visited := make([]bool, m*n)
+	var filled []Point
+
+	bfs(ss, start, nb, func(q Point) bool {
+		return !visited[q.Row*n+q.Col] && ss[q.Row][q.Col] == old
+	}, func(q, _ Point) {
+		visited[q.Row*n+q.Col] = true
+		filled = append(filled, q)
+	})
+
+	for _, p := range filled {
+		ss[p.Row][p.Col] = v
+	}
+	return filled
+}
+
+// ForEachNeighbor calls f with the position of every cell of the grid ss adjacent to p.
+// With Wrap, a cell may be reported more than once on grids less than 3 cells wide or high.
+func ForEachNeighbor[SS ~[]S, S ~[]E, E any](ss SS, p Point, nb Neighborhood, f func(Point)) {
+	m, n := gridShape(ss)
+	steps := orthogonalSteps
+	if nb.Diagonal {
+		steps = diagonalSteps
+	}
+
+	for _, d := range steps {
+		q := Point{p.Row + d.Row, p.Col + d.Col}
+		if nb.Wrap && m > 0 && n > 0 {
+			q = Point{(q.Row%m + m) % m, (q.Col%n + n) % n}
+		}
+		if inGrid(q, m, n) && q != p {
+			f(q)
+		}
+	}
+}
+
+// Manhattan returns the Manhattan distance between a and b, a heuristic for AStar with 4-connectivity.
+func Manhattan(a, b Point) int {
+	return abs(a.Row-b.Row) + abs(a.Col-b.Col)
+}
+
+// Neighbors returns the positions of the cells of the grid ss adjacent to p.
+func Neighbors[SS ~[]S, S ~[]E, E any](ss SS, p Point, nb Neighborhood) []Point {
+	var r []Point
+	ForEachNeighbor(ss, p, nb, func(q Point) {
+		if !Includes(r, q) {
+			r = append(r, q)
+		}
+	})
+	return r
+}
+
+// ShortestPath returns a shortest path from start to goal through passable cells of the grid ss,
+// found with a breadth-first search. The path includes start and goal.
+// It returns nil and false if there is no path.
+func ShortestPath[SS ~[]S, S ~[]E, E any](ss SS, start, goal Point, nb Neighborhood, passable func(E) bool) ([]Point, bool) {
+	m, n := gridShape(ss)
+	if !inGrid(start, m, n) || !inGrid(goal, m, n) || !passable(ss[start.Row][start.Col]) {
+		return nil, false
+	}
+
+	visited := make([]bool, m*n)
+	prev := make([]int, m*n)
+	bfs(ss, start, nb, func(q Point) bool {
+		return !visited[q.Row*n+q.Col] && passable(ss[q.Row][q.Col])
+	}, func(q, from Point) {
+		visited[q.Row*n+q.Col] = true
+		prev[q.Row*n+q.Col] = from.Row*n + from.Col
+	})
+
+	if !visited[goal.Row*n+goal.Col] {
+		return nil, false
+	}
+	return tracePath(prev, start, goal, n), true
+}
+
+// bfs visits the cells reachable from start through cells accepted by enter in breadth-first order,
+// calling visit with each cell and the cell it was reached from. enter must reject visited cells.
+func bfs[SS ~[]S, S ~[]E, E any](ss SS, start Point, nb Neighborhood, enter func(Point) bool, visit func(q, from Point)) {
+	visit(start, start)
+	queue := []Point{start}
+
+	for len(queue) > 0 {
+		var p Point
+		p, queue = Shift(queue)
+
+		ForEachNeighbor(ss, p, nb, func(q Point) {
+			if enter(q) {
+				visit(q, p)
+				queue = append(queue, q)
+			}
+		})
+	}
+}
+
+func inGrid(p Point, m, n int) bool {
+	return p.Row >= 0 && p.Row < m && p.Col >= 0 && p.Col < n
+}
+
+// tracePath follows prev, which maps the row-major index of each cell to that of its predecessor,
+// back from goal to start and returns the path from start to goal.
+func tracePath(prev []int, start, goal Point, n int) []Point {
+	path := []Point{goal}
+	for p := goal; p != start; {
+		i := prev[p.Row*n+p.Col]
+		p = Point{i / n, i % n}
+		path = append(path, p)
+	}
+
+	Reverse(path)
+	return path
+}
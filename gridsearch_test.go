@@ -0,0 +1,159 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func parseGrid(rows ...string) [][]byte {
+	return slice.Map(rows, func(r string) []byte { return []byte(r) })
+}
+
+func open(c byte) bool { return c != '#' }
+
+func validPath(t *testing.T, grid [][]byte, path []slice.Point, nb slice.Neighborhood) {
+	t.Helper()
+	for i, p := range path {
+		if !open(grid[p.Row][p.Col]) {
+			t.Errorf("path %v passes through wall at %v", path, p)
+		}
+		if i > 0 && !slice.Includes(slice.Neighbors(grid, path[i-1], nb), p) {
+			t.Errorf("path %v has non-adjacent step %v -> %v", path, path[i-1], p)
+		}
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	grid := slice.Make2D[int](3, 4)
+
+	testCases := []struct {
+		p    slice.Point
+		nb   slice.Neighborhood
+		want []slice.Point
+	}{
+		{slice.Point{1, 1}, slice.Neighborhood{}, []slice.Point{{0, 1}, {1, 0}, {1, 2}, {2, 1}}},
+		{slice.Point{0, 0}, slice.Neighborhood{}, []slice.Point{{0, 1}, {1, 0}}},
+		{slice.Point{0, 0}, slice.Neighborhood{Diagonal: true}, []slice.Point{{0, 1}, {1, 0}, {1, 1}}},
+		{slice.Point{0, 0}, slice.Neighborhood{Wrap: true}, []slice.Point{{2, 0}, {0, 3}, {0, 1}, {1, 0}}},
+		{slice.Point{0, 0}, slice.Neighborhood{Diagonal: true, Wrap: true}, []slice.Point{{2, 3}, {2, 0}, {2, 1}, {0, 3}, {0, 1}, {1, 3}, {1, 0}, {1, 1}}},
+	}
+
+	for _, c := range testCases {
+		if r := slice.Neighbors(grid, c.p, c.nb); !slice.Equals(r, c.want) {
+			t.Errorf("Neighbors(%v, %+v) = %v, want %v", c.p, c.nb, r, c.want)
+		}
+	}
+
+	if r := slice.Neighbors(slice.Make2D[int](1, 2), slice.Point{0, 0}, slice.Neighborhood{Wrap: true}); !slice.Equals(r, []slice.Point{{0, 1}}) {
+		t.Errorf("Neighbors on a 1x2 wrapping grid = %v, want [{0 1}]", r)
+	}
+}
+
+func TestFloodFill(t *testing.T) {
+	grid := parseGrid(
+		"..#.",
+		".##.",
+		"#..#",
+	)
+
+	filled := slice.FloodFill(grid, slice.Point{0, 0}, 'x', slice.Neighborhood{})
+	if want := parseGrid("xx#.", "x##.", "#..#"); !equals2D(grid, want) || len(filled) != 3 {
+		t.Errorf("FloodFill = %q, %v, want %q", grid, filled, want)
+	}
+
+	slice.FloodFill(grid, slice.Point{1, 0}, 'o', slice.Neighborhood{Diagonal: true})
+	if want := parseGrid("oo#.", "o##.", "#..#"); !equals2D(grid, want) {
+		t.Errorf("FloodFill = %q, want %q", grid, want)
+	}
+
+	slice.FloodFill(grid, slice.Point{2, 1}, 'o', slice.Neighborhood{Diagonal: true})
+	if want := parseGrid("oo#o", "o##o", "#oo#"); !equals2D(grid, want) {
+		t.Errorf("FloodFill = %q, want %q", grid, want)
+	}
+
+	if r := slice.FloodFill(grid, slice.Point{5, 5}, 'o', slice.Neighborhood{}); r != nil {
+		t.Errorf("FloodFill outside the grid = %v, want nil", r)
+	}
+}
+
+func TestConnectedComponents(t *testing.T) {
+	grid := [][]int{
+		{1, 1, 0},
+		{0, 1, 0},
+		{1, 0, 1},
+	}
+
+	labels, n := slice.ConnectedComponents(grid, slice.Neighborhood{})
+	if want := [][]int{{0, 0, 1}, {2, 0, 1}, {3, 4, 5}}; n != 6 || !equals2D(labels, want) {
+		t.Errorf("ConnectedComponents(4) = %v, %v, want %v, 6", labels, n, want)
+	}
+
+	labels, n = slice.ConnectedComponents(grid, slice.Neighborhood{Diagonal: true})
+	if want := [][]int{{0, 0, 1}, {1, 0, 1}, {0, 1, 0}}; n != 2 || !equals2D(labels, want) {
+		t.Errorf("ConnectedComponents(8) = %v, %v, want %v, 2", labels, n, want)
+	}
+}
+
+func TestShortestPath(t *testing.T) {
+	grid := parseGrid(
+		"....#",
+		".##.#",
+		"...#.",
+		"#....",
+	)
+	start, goal := slice.Point{0, 0}, slice.Point{2, 4}
+
+	path, ok := slice.ShortestPath(grid, start, goal, slice.Neighborhood{}, open)
+	if !ok || len(path) != 9 || path[0] != start || path[len(path)-1] != goal {
+		t.Errorf("ShortestPath = %v, %v, want a path of 9 cells", path, ok)
+	}
+	validPath(t, grid, path, slice.Neighborhood{})
+
+	path, ok = slice.ShortestPath(grid, start, goal, slice.Neighborhood{Diagonal: true}, open)
+	if !ok || len(path) != 5 {
+		t.Errorf("ShortestPath(8) = %v, %v, want a path of 5 cells", path, ok)
+	}
+	validPath(t, grid, path, slice.Neighborhood{Diagonal: true})
+
+	if path, ok := slice.ShortestPath(grid, start, slice.Point{0, 4}, slice.Neighborhood{}, open); ok {
+		t.Errorf("ShortestPath to a wall = %v, want no path", path)
+	}
+	if path, ok := slice.ShortestPath(grid, start, start, slice.Neighborhood{}, open); !ok || !slice.Equals(path, []slice.Point{start}) {
+		t.Errorf("ShortestPath to start = %v, %v", path, ok)
+	}
+}
+
+func TestAStar(t *testing.T) {
+	grid := parseGrid(
+		".........",
+		".#######.",
+		".#.....#.",
+		".#.###.#.",
+		"...#....#",
+	)
+	start, goal := slice.Point{4, 0}, slice.Point{2, 6}
+
+	for _, nb := range []slice.Neighborhood{{}, {Diagonal: true}} {
+		want, _ := slice.ShortestPath(grid, start, goal, nb, open)
+		heuristic := slice.Manhattan
+		if nb.Diagonal {
+			heuristic = slice.Chebyshev
+		}
+
+		path, ok := slice.AStar(grid, start, goal, nb, open, heuristic)
+		if !ok || len(path) != len(want) || path[0] != start || path[len(path)-1] != goal {
+			t.Errorf("AStar(%+v) = %v, %v, want a path of %v cells", nb, path, ok, len(want))
+		}
+		validPath(t, grid, path, nb)
+	}
+
+	if path, ok := slice.AStar(grid, start, slice.Point{4, 8}, slice.Neighborhood{}, open, slice.Manhattan); ok {
+		t.Errorf("AStar to a wall = %v, want no path", path)
+	}
+
+	blocked := parseGrid("..#..")
+	if path, ok := slice.AStar(blocked, slice.Point{0, 0}, slice.Point{0, 4}, slice.Neighborhood{}, open, slice.Manhattan); ok {
+		t.Errorf("AStar through a wall = %v, want no path", path)
+	}
+}
@@ -0,0 +1,126 @@
+package slice
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Bins is the result of a histogram. Counts[i] is the number of values in the bin [Edges[i], Edges[i+1]);
+// the last bin also includes its upper edge. Values outside the edges and NaN values are not counted.
+type Bins struct {
+	Edges  []float64
+	Counts []int
+}
+
+// Render returns an ASCII rendering of the histogram with one line per bin, showing the bin range,
+// a bar of at most width '#' characters scaled to the largest count, and the count.
+// A negative width is treated as zero.
+func (b Bins) Render(width int) string {
+	width = maxInt(width, 0)
+	labels := make([]string, len(b.Counts))
+	for i := range b.Counts {
+		closing := ")"
+		if i == len(b.Counts)-1 {
+			closing = "]"
+		}
+		labels[i] = fmt.Sprintf("[%g, %g%s", b.Edges[i], b.Edges[i+1], closing)
+	}
+
+	longest, _ := MaxBy(labels, func(s string) int { return len(s) })
+	maxCount, _ := Max(b.Counts)
+
+	var sb strings.Builder
+	for i, c := range b.Counts {
+		bar := 0
+		if maxCount > 0 {
+			bar = c * width / maxCount
+		}
+		fmt.Fprintf(&sb, "%-*s %s %d\n", len(longest), labels[i], strings.Repeat("#", bar), c)
+	}
+	return sb.String()
+}
+
+// Digitize returns, for every element of s, the index of the bin of the sorted edges it falls in:
+// i such that edges[i-1] <= v < edges[i], 0 if v < edges[0], and len(edges) if v >= edges[len(edges)-1]
+// or v is NaN.
+func Digitize[S ~[]E, E Real](s S, edges []float64) []int {
+	return Map(s, func(v E) int { return UpperBound(edges, float64(v)) })
+}
+
+// GroupByBin groups the elements of s by the index of the bin of the sorted edges they fall in,
+// as returned by Digitize.
+func GroupByBin[S ~[]E, E Real](s S, edges []float64) map[int]S {
+	return Group(s, func(v E) int { return UpperBound(edges, float64(v)) })
+}
+
+// Histogram returns a histogram of s with the given number of bins of equal width spanning the
+// range of the values. If all values are equal, the range is extended by 0.5 on each side.
+// NaN and infinite values are ignored. It returns empty Bins if s has no finite values or bins <= 0.
+func Histogram[S ~[]E, E Real](s S, bins int) Bins {
+	x := finiteFloats(s)
+	lo, hi, ok := MinMax(x)
+	if !ok || bins <= 0 {
+		return Bins{}
+	}
+	if lo == hi {
+		lo, hi = lo-0.5, hi+0.5
+	}
+
+	// Scaling lo and hi separately keeps the step finite even when hi-lo overflows.
+	edges := make([]float64, bins+1)
+	for i := range edges {
+		edges[i] = lo + float64(i)*(hi/float64(bins)-lo/float64(bins))
+	}
+	edges[bins] = hi
+
+	return HistogramEdges(x, edges)
+}
+
+// HistogramEdges returns a histogram of s over the given sorted bin edges.
+// It returns empty Bins if there are fewer than two edges.
+func HistogramEdges[S ~[]E, E Real](s S, edges []float64) Bins {
+	if len(edges) < 2 {
+		return Bins{}
+	}
+
+	last := len(edges) - 1
+	counts := make([]int, last)
+	for _, v := range s {
+		f := float64(v)
+		if !(f >= edges[0] && f <= edges[last]) {
+			continue
+		}
+
+		i := UpperBound(edges, f) - 1
+		if i == last {
+			i--
+		}
+		counts[i]++
+	}
+
+	return Bins{Clone(edges), counts}
+}
+
+// HistogramEqualFreq returns a histogram of s with the given number of bins, whose edges are the
+// quantiles of s so that each bin holds about the same number of values.
+// NaN and infinite values are ignored. It returns empty Bins if s has no finite values or bins <= 0.
+func HistogramEqualFreq[S ~[]E, E Real](s S, bins int) Bins {
+	x := finiteFloats(s)
+	if len(x) == 0 || bins <= 0 {
+		return Bins{}
+	}
+
+	qs := make([]float64, bins+1)
+	for i := range qs {
+		qs[i] = float64(i) / float64(bins)
+	}
+
+	return HistogramEdges(x, Quantiles(x, qs, QuantileLinear))
+}
+
+// finiteFloats returns the finite elements of s converted to float64.
+func finiteFloats[S ~[]E, E Real](s S) []float64 {
+	x := Map(s, func(v E) float64 { return float64(v) })
+	return Filter(x, func(v float64) bool { return !math.IsNaN(v) && !math.IsInf(v, 0) })
+}
@@ -0,0 +1,102 @@
+package slice_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func TestBinsRender(t *testing.T) {
+	b := slice.Bins{Edges: []float64{0, 5, 10}, Counts: []int{4, 2}}
+	want := "[0, 5)  ######## 4\n[5, 10] #### 2\n"
+
+	if r := b.Render(8); r != want {
+		t.Errorf("Render(8) = %q, want %q", r, want)
+	}
+	if want := "[0, 5)   4\n[5, 10]  2\n"; b.Render(-3) != want {
+		t.Errorf("Render(-3) = %q, want %q", b.Render(-3), want)
+	}
+	if r := (slice.Bins{}).Render(8); r != "" {
+		t.Errorf("Render of empty Bins = %q, want empty", r)
+	}
+}
+
+func TestDigitize(t *testing.T) {
+	edges := []float64{0, 1, 2}
+	s := []float64{-1, 0, 0.5, 1, 2, 3, math.NaN()}
+	want := []int{0, 1, 1, 2, 3, 3, 3}
+
+	if r := slice.Digitize(s, edges); !slice.Equals(r, want) {
+		t.Errorf("Digitize(%v, %v) = %v, want %v", s, edges, r, want)
+	}
+}
+
+func TestGroupByBin(t *testing.T) {
+	edges := []float64{10, 20}
+	s := []int{5, 15, 12, 25}
+	r := slice.GroupByBin(s, edges)
+
+	if len(r) != 3 || !slice.Equals(r[0], []int{5}) || !slice.Equals(r[1], []int{15, 12}) || !slice.Equals(r[2], []int{25}) {
+		t.Errorf("GroupByBin(%v, %v) = %v", s, edges, r)
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	testCases := []struct {
+		s      []float64
+		bins   int
+		edges  []float64
+		counts []int
+	}{
+		{[]float64{0, 1, 2, 3, 4, 10}, 2, []float64{0, 5, 10}, []int{5, 1}},
+		{[]float64{1, 1, math.NaN()}, 2, []float64{0.5, 1, 1.5}, []int{0, 2}},
+		{[]float64{1, math.Inf(1)}, 2, []float64{0.5, 1, 1.5}, []int{0, 1}},
+		{[]float64{math.Inf(-1), 0, 4, math.Inf(1)}, 2, []float64{0, 2, 4}, []int{1, 1}},
+		{[]float64{math.Inf(1), math.NaN()}, 2, nil, nil},
+		{[]float64{-1e308, 1e308}, 4, []float64{-1e308, -5e307, 0, 5e307, 1e308}, []int{1, 0, 0, 1}},
+		{nil, 3, nil, nil},
+		{[]float64{1}, 0, nil, nil},
+	}
+
+	for _, c := range testCases {
+		if r := slice.Histogram(c.s, c.bins); !slice.Equals(r.Edges, c.edges) || !slice.Equals(r.Counts, c.counts) {
+			t.Errorf("Histogram(%v, %v) = %v, want %v, %v", c.s, c.bins, r, c.edges, c.counts)
+		}
+	}
+}
+
+func TestHistogramEdges(t *testing.T) {
+	testCases := []struct {
+		s      []int
+		edges  []float64
+		counts []int
+	}{
+		{[]int{0, 1, 5, 9, 10, 11, -1}, []float64{0, 5, 10}, []int{2, 3}},
+		{[]int{1, 2}, []float64{0}, nil},
+	}
+
+	for _, c := range testCases {
+		if r := slice.HistogramEdges(c.s, c.edges); !slice.Equals(r.Counts, c.counts) {
+			t.Errorf("HistogramEdges(%v, %v) = %v, want %v", c.s, c.edges, r.Counts, c.counts)
+		}
+	}
+}
+
+func TestHistogramEqualFreq(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6, 7, 8, 100}
+	r := slice.HistogramEqualFreq(s, 2)
+
+	if want := []float64{1, 5, 100}; !slice.Equals(r.Edges, want) {
+		t.Errorf("HistogramEqualFreq(%v, 2) edges = %v, want %v", s, r.Edges, want)
+	}
+	if want := []int{4, 5}; !slice.Equals(r.Counts, want) {
+		t.Errorf("HistogramEqualFreq(%v, 2) counts = %v, want %v", s, r.Counts, want)
+	}
+
+	f := []float64{math.Inf(-1), 1, 2, 3, 4, math.Inf(1), math.NaN()}
+	r = slice.HistogramEqualFreq(f, 2)
+	if want := []float64{1, 2.5, 4}; !slice.Equals(r.Edges, want) || !slice.Equals(r.Counts, []int{2, 2}) {
+		t.Errorf("HistogramEqualFreq(%v, 2) = %v, want edges %v and counts [2 2]", f, r, want)
+	}
+}
@@ -0,0 +1,320 @@
+package slice
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrSingular is returned when a matrix is singular or rank-deficient to working precision.
+var ErrSingular = errors.New("slice: matrix is singular")
+
+// LU is the LU decomposition with partial pivoting of a square matrix A, such that P*A = L*U,
+// where P is a permutation matrix, L is unit lower triangular and U is upper triangular.
+type LU struct {
+	lu       [][]float64
+	piv      []int
+	sign     float64
+	singular bool
+}
+
+// LUDecompose returns the LU decomposition of the square matrix a, which is not modified.
+// It returns ErrLengthMismatch if a is not square. A singular matrix is decomposed without error;
+// its singularity is reported by Singular, Solve and Inverse.
+func LUDecompose(a [][]float64) (*LU, error) {
+	n := len(a)
+	if !isRectangular(a, n) {
+		return nil, ErrLengthMismatch
+	}
+
+	lu := Make2D[float64](n, n)
+	for i, row := range a {
+		copy(lu[i], row)
+	}
+
+	tol := float64(n) * epsilon * maxAbs2D(lu)
+	d := &LU{lu: lu, piv: Range(0, n), sign: 1}
+
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(lu[i][k]) > math.Abs(lu[p][k]) {
+				p = i
+			}
+		}
+		if p != k {
+			lu[p], lu[k] = lu[k], lu[p]
+			d.piv[p], d.piv[k] = d.piv[k], d.piv[p]
+			d.sign = -d.sign
+		}
+
+		if math.Abs(lu[k][k]) <= tol {
+			d.singular = true
+			continue
+		}
+
+		for i := k + 1; i < n; i++ {
+			f := lu[i][k] / lu[k][k]
+			lu[i][k] = f
+			for j := k + 1; j < n; j++ {
+				lu[i][j] -= f * lu[k][j]
+			}
+		}
+	}
+
+	return d, nil
+}
+
+// Determinant returns the determinant of the decomposed matrix.
+func (d *LU) Determinant() float64 {
+	if d.singular {
+		return 0
+	}
+
+	det := d.sign
+	for i, row := range d.lu {
+		det *= row[i]
+	}
+	return det
+}
+
+// Inverse returns the inverse of the decomposed matrix, or ErrSingular.
+func (d *LU) Inverse() ([][]float64, error) {
+	if d.singular {
+		return nil, ErrSingular
+	}
+
+	n := len(d.lu)
+	inv := Make2D[float64](n, n)
+	e := make([]float64, n)
+
+	for j := 0; j < n; j++ {
+		Fill(e, 0)
+		e[j] = 1
+
+		x, _ := d.Solve(e)
+		for i, v := range x {
+			inv[i][j] = v
+		}
+	}
+	return inv, nil
+}
+
+// L returns the unit lower triangular factor.
+func (d *LU) L() [][]float64 {
+	n := len(d.lu)
+	l := Make2D[float64](n, n)
+	for i, row := range d.lu {
+		copy(l[i], row[:i])
+		l[i][i] = 1
+	}
+	return l
+}
+
+// Pivot returns the row permutation: row i of P*A is row Pivot()[i] of A.
+func (d *LU) Pivot() []int {
+	return Clone(d.piv)
+}
+
+// Singular returns true if the decomposed matrix is singular to working precision.
+func (d *LU) Singular() bool {
+	return d.singular
+}
+
+// Solve returns x such that A*x = b, or ErrSingular, or ErrLengthMismatch if len(b) is not the
+// order of A.
+func (d *LU) Solve(b []float64) ([]float64, error) {
+	n := len(d.lu)
+	if len(b) != n {
+		return nil, ErrLengthMismatch
+	}
+	if d.singular {
+		return nil, ErrSingular
+	}
+
+	x := make([]float64, n)
+	for i, p := range d.piv {
+		x[i] = b[p]
+	}
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			x[i] -= d.lu[i][j] * x[j]
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			x[i] -= d.lu[i][j] * x[j]
+		}
+		x[i] /= d.lu[i][i]
+	}
+
+	return x, nil
+}
+
+// U returns the upper triangular factor.
+func (d *LU) U() [][]float64 {
+	n := len(d.lu)
+	u := Make2D[float64](n, n)
+	for i, row := range d.lu {
+		copy(u[i][i:], row[i:])
+	}
+	return u
+}
+
+// Determinant returns the determinant of the square matrix a, or ErrLengthMismatch if a is not square.
+// The determinant of a singular matrix is 0.
+func Determinant(a [][]float64) (float64, error) {
+	d, err := LUDecompose(a)
+	if err != nil {
+		return 0, err
+	}
+	return d.Determinant(), nil
+}
+
+// Inverse returns the inverse of the square matrix a, or ErrSingular if a is singular,
+// or ErrLengthMismatch if a is not square.
+func Inverse(a [][]float64) ([][]float64, error) {
+	d, err := LUDecompose(a)
+	if err != nil {
+		return nil, err
+	}
+	return d.Inverse()
+}
+
+// LeastSquares returns x minimizing the Euclidean norm of A*x - b for the m×n matrix a with m >= n,
+// computed with a QR decomposition. It returns ErrSingular if a does not have full column rank,
+// or ErrLengthMismatch if the shapes do not fit.
+func LeastSquares(a [][]float64, b []float64) ([]float64, error) {
+	m := len(a)
+	if m == 0 || len(b) != m {
+		return nil, ErrLengthMismatch
+	}
+	n := len(a[0])
+	if m < n {
+		return nil, ErrLengthMismatch
+	}
+
+	q, r, err := QRDecompose(a)
+	if err != nil {
+		return nil, err
+	}
+
+	tol := float64(m) * epsilon * maxAbs2D(r)
+	x := make([]float64, n)
+	for i := 0; i < n; i++ {
+		for k := 0; k < m; k++ {
+			x[i] += q[k][i] * b[k]
+		}
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		if math.Abs(r[i][i]) <= tol {
+			return nil, ErrSingular
+		}
+		for j := i + 1; j < n; j++ {
+			x[i] -= r[i][j] * x[j]
+		}
+		x[i] /= r[i][i]
+	}
+
+	return x, nil
+}
+
+// QRDecompose returns the QR decomposition of the m×n matrix a, which is not modified, computed
+// with Householder reflections: q is an m×m orthogonal matrix and r is an m×n upper triangular
+// matrix such that A = Q*R. It returns ErrLengthMismatch if the rows of a have different lengths.
+func QRDecompose(a [][]float64) (q, r [][]float64, err error) {
+	m := len(a)
+	var n int
+	if m > 0 {
+		n = len(a[0])
+	}
+	if !isRectangular(a, n) {
+		return nil, nil, ErrLengthMismatch
+	}
+
+	r = Make2D[float64](m, n)
+	for i, row := range a {
+		copy(r[i], row)
+	}
+	q = Make2D[float64](m, m)
+	for i := range q {
+		q[i][i] = 1
+	}
+
+	v := make([]float64, m)
+	for k := 0; k < n && k < m-1; k++ {
+		var norm float64
+		for i := k; i < m; i++ {
+			norm = math.Hypot(norm, r[i][k])
+		}
+		if norm == 0 {
+			continue
+		}
+		if r[k][k] > 0 {
+			norm = -norm
+		}
+
+		// v is the Householder vector that reflects column k onto norm*e_k.
+		var vv float64
+		for i := k; i < m; i++ {
+			v[i] = r[i][k]
+		}
+		v[k] -= norm
+		for i := k; i < m; i++ {
+			vv += v[i] * v[i]
+		}
+
+		for j := 0; j < n; j++ {
+			var s float64
+			for i := k; i < m; i++ {
+				s += v[i] * r[i][j]
+			}
+			s = 2 * s / vv
+			for i := k; i < m; i++ {
+				r[i][j] -= s * v[i]
+			}
+		}
+		for i := 0; i < m; i++ {
+			var s float64
+			for l := k; l < m; l++ {
+				s += q[i][l] * v[l]
+			}
+			s = 2 * s / vv
+			for l := k; l < m; l++ {
+				q[i][l] -= s * v[l]
+			}
+		}
+		for i := k + 1; i < m; i++ {
+			r[i][k] = 0
+		}
+	}
+
+	return q, r, nil
+}
+
+// Solve returns x such that A*x = b for the square matrix a, or ErrSingular if a is singular,
+// or ErrLengthMismatch if the shapes do not fit.
+func Solve(a [][]float64, b []float64) ([]float64, error) {
+	d, err := LUDecompose(a)
+	if err != nil {
+		return nil, err
+	}
+	return d.Solve(b)
+}
+
+// epsilon is the machine epsilon of float64.
+const epsilon = 0x1p-52
+
+// isRectangular returns true if every row of a has length n.
+func isRectangular(a [][]float64, n int) bool {
+	return Every(a, func(row []float64) bool { return len(row) == n })
+}
+
+func maxAbs2D(a [][]float64) float64 {
+	var r float64
+	for _, row := range a {
+		r = math.Max(r, Norm(row, NormInf))
+	}
+	return r
+}
@@ -0,0 +1,162 @@
+package slice_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func matMul(a, b [][]float64) [][]float64 {
+	r := slice.Make2D[float64](len(a), len(b[0]))
+	for i := range a {
+		for j := range b[0] {
+			for k := range b {
+				r[i][j] += a[i][k] * b[k][j]
+			}
+		}
+	}
+	return r
+}
+
+func approx2D(a, b [][]float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if len(a[i]) != len(b[i]) {
+			return false
+		}
+		for j := range a[i] {
+			if math.Abs(a[i][j]-b[i][j]) > 1e-9 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func approx(a, b []float64) bool {
+	return approx2D([][]float64{a}, [][]float64{b})
+}
+
+func TestLUDecompose(t *testing.T) {
+	a := [][]float64{{2, 1, 1}, {4, -6, 0}, {-2, 7, 2}}
+	d, err := slice.LUDecompose(a)
+	if err != nil {
+		t.Fatalf("LUDecompose returned error %v", err)
+	}
+
+	pa := slice.Map(d.Pivot(), func(i int) []float64 { return a[i] })
+	if r := matMul(d.L(), d.U()); !approx2D(r, pa) {
+		t.Errorf("L*U = %v, want %v", r, pa)
+	}
+	if d.Singular() {
+		t.Errorf("Singular() = true, want false")
+	}
+
+	if _, err := slice.LUDecompose([][]float64{{1, 2}}); !errors.Is(err, slice.ErrLengthMismatch) {
+		t.Errorf("LUDecompose of non-square matrix returned error %v", err)
+	}
+}
+
+func TestDeterminant(t *testing.T) {
+	testCases := []struct {
+		a    [][]float64
+		want float64
+	}{
+		{[][]float64{{2, 1, 1}, {4, -6, 0}, {-2, 7, 2}}, -16},
+		{[][]float64{{0, 1}, {1, 0}}, -1},
+		{[][]float64{{1, 2}, {2, 4}}, 0},
+		{[][]float64{{3}}, 3},
+	}
+
+	for _, c := range testCases {
+		if r, err := slice.Determinant(c.a); err != nil || math.Abs(r-c.want) > 1e-9 {
+			t.Errorf("Determinant(%v) = %v, %v, want %v", c.a, r, err, c.want)
+		}
+	}
+}
+
+func TestInverse(t *testing.T) {
+	a := [][]float64{{4, 7}, {2, 6}}
+	inv, err := slice.Inverse(a)
+	if err != nil {
+		t.Fatalf("Inverse returned error %v", err)
+	}
+	if r := matMul(a, inv); !approx2D(r, [][]float64{{1, 0}, {0, 1}}) {
+		t.Errorf("A*Inverse(A) = %v, want identity", r)
+	}
+
+	if _, err := slice.Inverse([][]float64{{1, 2}, {2, 4}}); !errors.Is(err, slice.ErrSingular) {
+		t.Errorf("Inverse of singular matrix returned error %v", err)
+	}
+}
+
+func TestSolve(t *testing.T) {
+	a := [][]float64{{2, 1, -1}, {-3, -1, 2}, {-2, 1, 2}}
+	b := []float64{8, -11, -3}
+
+	if x, err := slice.Solve(a, b); err != nil || !approx(x, []float64{2, 3, -1}) {
+		t.Errorf("Solve = %v, %v, want [2 3 -1]", x, err)
+	}
+	if _, err := slice.Solve([][]float64{{1, 1}, {1, 1}}, []float64{1, 2}); !errors.Is(err, slice.ErrSingular) {
+		t.Errorf("Solve of singular system returned error %v", err)
+	}
+	if _, err := slice.Solve(a, []float64{1}); !errors.Is(err, slice.ErrLengthMismatch) {
+		t.Errorf("Solve with short b returned error %v", err)
+	}
+}
+
+func TestQRDecompose(t *testing.T) {
+	a := [][]float64{{12, -51, 4}, {6, 167, -68}, {-4, 24, -41}, {1, 2, 3}}
+	q, r, err := slice.QRDecompose(a)
+	if err != nil {
+		t.Fatalf("QRDecompose returned error %v", err)
+	}
+
+	if qr := matMul(q, r); !approx2D(qr, a) {
+		t.Errorf("Q*R = %v, want %v", qr, a)
+	}
+
+	qt := slice.Make2D[float64](len(q), len(q))
+	for i := range q {
+		for j := range q {
+			qt[j][i] = q[i][j]
+		}
+	}
+	if r := matMul(qt, q); !approx2D(r, slice.Map(slice.Range(0, 4), func(i int) []float64 {
+		row := make([]float64, 4)
+		row[i] = 1
+		return row
+	})) {
+		t.Errorf("Q is not orthogonal")
+	}
+
+	for i := range r {
+		for j := 0; j < i && j < len(r[i]); j++ {
+			if r[i][j] != 0 {
+				t.Errorf("R is not upper triangular at %v, %v", i, j)
+			}
+		}
+	}
+}
+
+func TestLeastSquares(t *testing.T) {
+	// Fit y = 1 + 2x exactly, then a noisy line.
+	a := [][]float64{{1, 0}, {1, 1}, {1, 2}, {1, 3}}
+	if x, err := slice.LeastSquares(a, []float64{1, 3, 5, 7}); err != nil || !approx(x, []float64{1, 2}) {
+		t.Errorf("LeastSquares = %v, %v, want [1 2]", x, err)
+	}
+	if x, err := slice.LeastSquares(a, []float64{1.5, 2.5, 5.5, 6.5}); err != nil || !approx(x, []float64{1.3, 1.8}) {
+		t.Errorf("LeastSquares = %v, %v, want [1.3 1.8]", x, err)
+	}
+
+	if _, err := slice.LeastSquares([][]float64{{1, 2}, {2, 4}, {3, 6}}, []float64{1, 2, 3}); !errors.Is(err, slice.ErrSingular) {
+		t.Errorf("LeastSquares of rank-deficient matrix returned error %v", err)
+	}
+	if _, err := slice.LeastSquares([][]float64{{1, 2}}, []float64{1}); !errors.Is(err, slice.ErrLengthMismatch) {
+		t.Errorf("LeastSquares of wide matrix returned error %v", err)
+	}
+}
@@ -0,0 +1,191 @@
+package slice
+
+// matrixBlock is the tile size used by Matrix.Mul to keep the working set in cache.
+const matrixBlock = 64
+
+// Matrix is a dense matrix stored in a single row-major backing slice, the same layout that Make2D
+// allocates for its rows.
+type Matrix[T Number] struct {
+	rows, cols int
+	data       []T
+}
+
+// IdentityMatrix returns the n×n identity matrix.
+func IdentityMatrix[T Number](n int) *Matrix[T] {
+	m := ZeroMatrix[T](n, n)
+	for i := 0; i < n; i++ {
+		m.data[i*n+i] = 1
+	}
+	return m
+}
+
+// MatrixFrom2D returns a new Matrix holding a copy of the rows of ss.
+// It returns ErrLengthMismatch if the rows have different lengths.
+func MatrixFrom2D[SS ~[]S, S ~[]T, T Number](ss SS) (*Matrix[T], error) {
+	var cols int
+	if len(ss) > 0 {
+		cols = len(ss[0])
+	}
+
+	m := ZeroMatrix[T](len(ss), cols)
+	for i, s := range ss {
+		if len(s) != cols {
+			return nil, ErrLengthMismatch
+		}
+		copy(m.Row(i), s)
+	}
+	return m, nil
+}
+
+// ZeroMatrix returns a new m×n matrix of zeros.
+func ZeroMatrix[T Number](m, n int) *Matrix[T] {
+	return &Matrix[T]{m, n, make([]T, m*n)}
+}
+
+// Add returns the element-wise sum of m and b, or ErrLengthMismatch if their shapes differ.
+func (m *Matrix[T]) Add(b *Matrix[T]) (*Matrix[T], error) {
+	if m.rows != b.rows || m.cols != b.cols {
+		return nil, ErrLengthMismatch
+	}
+
+	r := ZeroMatrix[T](m.rows, m.cols)
+	AddInto(r.data, m.data, b.data)
+	return r, nil
+}
+
+// At returns the element at row i and column j. It panics if the index is out of range.
+func (m *Matrix[T]) At(i, j int) T {
+	if i < 0 || i >= m.rows || j < 0 || j >= m.cols {
+		panic("slice: Matrix index out of range")
+	}
+	return m.data[i*m.cols+j]
+}
+
+// Clone returns a copy of m.
+func (m *Matrix[T]) Clone() *Matrix[T] {
+	return &Matrix[T]{m.rows, m.cols, Clone(m.data)}
+}
+
+// Col returns a copy of column j. It panics if j is out of range.
+func (m *Matrix[T]) Col(j int) []T {
+	if j < 0 || j >= m.cols {
+		panic("slice: Matrix index out of range")
+	}
+	c := make([]T, m.rows)
+	for i := range c {
+		c[i] = m.data[i*m.cols+j]
+	}
+	return c
+}
+
+// Cols returns the number of columns.
+func (m *Matrix[T]) Cols() int {
+	return m.cols
+}
+
+// Data returns the row-major backing slice of m. Changes to it are reflected in m.
+func (m *Matrix[T]) Data() []T {
+	return m.data
+}
+
+// Mul returns the matrix product of m and b, or ErrLengthMismatch if m.Cols() != b.Rows().
+// The product is computed in cache-sized tiles.
+func (m *Matrix[T]) Mul(b *Matrix[T]) (*Matrix[T], error) {
+	if m.cols != b.rows {
+		return nil, ErrLengthMismatch
+	}
+
+	r := ZeroMatrix[T](m.rows, b.cols)
+	n, p := m.cols, b.cols
+
+	for i0 := 0; i0 < m.rows; i0 += matrixBlock {
+		i1 := minInt(i0+matrixBlock, m.rows)
+		for k0 := 0; k0 < n; k0 += matrixBlock {
+			k1 := minInt(k0+matrixBlock, n)
+			for j0 := 0; j0 < p; j0 += matrixBlock {
+				j1 := minInt(j0+matrixBlock, p)
+
+				for i := i0; i < i1; i++ {
+					ri := r.data[i*p+j0 : i*p+j1]
+					for k := k0; k < k1; k++ {
+						a := m.data[i*n+k]
+						bk := b.data[k*p+j0 : k*p+j1]
+						for j, v := range bk {
+							ri[j] += a * v
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return r, nil
+}
+
+// Row returns row i as a view into m. Changes to it are reflected in m.
+// It panics if i is out of range.
+func (m *Matrix[T]) Row(i int) []T {
+	if i < 0 || i >= m.rows {
+		panic("slice: Matrix index out of range")
+	}
+	start, end := i*m.cols, (i+1)*m.cols
+	return m.data[start:end:end]
+}
+
+// Rows returns the number of rows.
+func (m *Matrix[T]) Rows() int {
+	return m.rows
+}
+
+// Scale returns m with every element multiplied by k.
+func (m *Matrix[T]) Scale(k T) *Matrix[T] {
+	return &Matrix[T]{m.rows, m.cols, Scale(m.data, k)}
+}
+
+// Set sets the element at row i and column j to v. It panics if the index is out of range.
+func (m *Matrix[T]) Set(i, j int, v T) {
+	if i < 0 || i >= m.rows || j < 0 || j >= m.cols {
+		panic("slice: Matrix index out of range")
+	}
+	m.data[i*m.cols+j] = v
+}
+
+// Shape returns the number of rows and columns.
+func (m *Matrix[T]) Shape() (rows, cols int) {
+	return m.rows, m.cols
+}
+
+// Sub returns the element-wise difference of m and b, or ErrLengthMismatch if their shapes differ.
+func (m *Matrix[T]) Sub(b *Matrix[T]) (*Matrix[T], error) {
+	if m.rows != b.rows || m.cols != b.cols {
+		return nil, ErrLengthMismatch
+	}
+
+	r := ZeroMatrix[T](m.rows, m.cols)
+	SubInto(r.data, m.data, b.data)
+	return r, nil
+}
+
+// To2D returns the rows of m as a 2D slice whose rows are views into m, as laid out by Make2D.
+func (m *Matrix[T]) To2D() [][]T {
+	if m.rows == 0 {
+		return nil
+	}
+
+	ss := make([][]T, m.rows)
+	for i := range ss {
+		ss[i] = m.Row(i)
+	}
+	return ss
+}
+
+// Transpose returns the transpose of m.
+func (m *Matrix[T]) Transpose() *Matrix[T] {
+	r := ZeroMatrix[T](m.cols, m.rows)
+	for i := 0; i < m.rows; i++ {
+		for j, v := range m.Row(i) {
+			r.data[j*m.rows+i] = v
+		}
+	}
+	return r
+}
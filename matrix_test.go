@@ -0,0 +1,140 @@
+package slice_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func mustMatrix(t *testing.T, ss [][]int) *slice.Matrix[int] {
+	t.Helper()
+	m, err := slice.MatrixFrom2D(ss)
+	if err != nil {
+		t.Fatalf("MatrixFrom2D(%v) returned error %v", ss, err)
+	}
+	return m
+}
+
+func TestMatrixFrom2D(t *testing.T) {
+	ss := [][]int{{1, 2, 3}, {4, 5, 6}}
+	m := mustMatrix(t, ss)
+
+	if r, c := m.Shape(); r != 2 || c != 3 || m.Rows() != 2 || m.Cols() != 3 {
+		t.Errorf("Shape() = %v, %v, want 2, 3", r, c)
+	}
+	if r := m.To2D(); !equals2D(r, ss) {
+		t.Errorf("To2D() = %v, want %v", r, ss)
+	}
+	if _, err := slice.MatrixFrom2D([][]int{{1, 2}, {3}}); !errors.Is(err, slice.ErrLengthMismatch) {
+		t.Errorf("MatrixFrom2D of ragged rows returned error %v", err)
+	}
+}
+
+func TestMatrixViews(t *testing.T) {
+	m := mustMatrix(t, [][]int{{1, 2}, {3, 4}})
+
+	m.Row(1)[0] = 9
+	if r := m.At(1, 0); r != 9 {
+		t.Errorf("At(1, 0) = %v after writing to Row(1), want 9", r)
+	}
+	m.Set(0, 1, 7)
+	if r, want := m.Col(1), []int{7, 4}; !slice.Equals(r, want) {
+		t.Errorf("Col(1) = %v, want %v", r, want)
+	}
+	if r, want := m.Data(), []int{1, 7, 9, 4}; !slice.Equals(r, want) {
+		t.Errorf("Data() = %v, want %v", r, want)
+	}
+
+	c := m.Clone()
+	c.Set(0, 0, 100)
+	if m.At(0, 0) != 1 {
+		t.Errorf("Clone shares storage with the original")
+	}
+}
+
+func TestMatrixOutOfRange(t *testing.T) {
+	m := mustMatrix(t, [][]int{{1, 2, 3}, {4, 5, 6}})
+
+	testCases := []struct {
+		name string
+		f    func()
+	}{
+		{"At(0, 3)", func() { m.At(0, 3) }},
+		{"At(2, 0)", func() { m.At(2, 0) }},
+		{"At(-1, 0)", func() { m.At(-1, 0) }},
+		{"Set(1, -1)", func() { m.Set(1, -1, 9) }},
+		{"Set(0, 3)", func() { m.Set(0, 3, 9) }},
+		{"Col(3)", func() { m.Col(3) }},
+		{"Col(-1)", func() { m.Col(-1) }},
+		{"Row(2)", func() { m.Row(2) }},
+	}
+
+	for _, c := range testCases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%v did not panic", c.name)
+				}
+			}()
+			c.f()
+		}()
+	}
+
+	if want := []int{1, 2, 3, 4, 5, 6}; !slice.Equals(m.Data(), want) {
+		t.Errorf("Data() = %v after out-of-range calls, want %v", m.Data(), want)
+	}
+}
+
+func TestMatrixArithmetic(t *testing.T) {
+	a := mustMatrix(t, [][]int{{1, 2}, {3, 4}})
+	b := mustMatrix(t, [][]int{{5, 6}, {7, 8}})
+
+	if r, err := a.Add(b); err != nil || !equals2D(r.To2D(), [][]int{{6, 8}, {10, 12}}) {
+		t.Errorf("Add = %v, %v", r, err)
+	}
+	if r, err := a.Sub(b); err != nil || !equals2D(r.To2D(), [][]int{{-4, -4}, {-4, -4}}) {
+		t.Errorf("Sub = %v, %v", r, err)
+	}
+	if r := a.Scale(2); !equals2D(r.To2D(), [][]int{{2, 4}, {6, 8}}) {
+		t.Errorf("Scale = %v", r)
+	}
+	if r := mustMatrix(t, [][]int{{1, 2, 3}}).Transpose(); !equals2D(r.To2D(), [][]int{{1}, {2}, {3}}) {
+		t.Errorf("Transpose = %v", r.To2D())
+	}
+	if _, err := a.Add(slice.ZeroMatrix[int](2, 3)); !errors.Is(err, slice.ErrLengthMismatch) {
+		t.Errorf("Add of different shapes returned error %v", err)
+	}
+}
+
+func TestMatrixMul(t *testing.T) {
+	a := mustMatrix(t, [][]int{{1, 2, 3}, {4, 5, 6}})
+	b := mustMatrix(t, [][]int{{7, 8}, {9, 10}, {11, 12}})
+
+	if r, err := a.Mul(b); err != nil || !equals2D(r.To2D(), [][]int{{58, 64}, {139, 154}}) {
+		t.Errorf("Mul = %v, %v", r, err)
+	}
+	if _, err := a.Mul(a); !errors.Is(err, slice.ErrLengthMismatch) {
+		t.Errorf("Mul of incompatible shapes returned error %v", err)
+	}
+
+	n := 150
+	m := slice.ZeroMatrix[int](n, n)
+	for i, v := range randomInts(n*n, 10) {
+		m.Data()[i] = v
+	}
+	if r, err := m.Mul(slice.IdentityMatrix[int](n)); err != nil || !slice.Equals(r.Data(), m.Data()) {
+		t.Errorf("Mul by identity changed the matrix")
+	}
+
+	r, _ := m.Mul(m)
+	for _, ij := range [][2]int{{0, 0}, {70, 149}, {149, 3}} {
+		var want int
+		for k := 0; k < n; k++ {
+			want += m.At(ij[0], k) * m.At(k, ij[1])
+		}
+		if got := r.At(ij[0], ij[1]); got != want {
+			t.Errorf("Mul At(%v, %v) = %v, want %v", ij[0], ij[1], got, want)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package slice
+
+// Conflict describes a region that was changed differently in ours and theirs during a three-way merge.
+// Base, Ours and Theirs hold the conflicting elements of each input. BaseStart is the index in base
+// at which the region starts, and [Start:End) is the range of the merged slice holding the resolution.
+type Conflict[E any] struct {
+	Base, Ours, Theirs []E
+	BaseStart          int
+	Start, End         int
+}
+
+// ConflictResolver returns the elements to put into the merged slice in place of a conflicting region.
+type ConflictResolver[E any] func(base, ours, theirs []E) []E
+
+// ResolveOurs resolves a conflict by taking our side.
+func ResolveOurs[E any](base, ours, theirs []E) []E {
+	return ours
+}
+
+// ResolveTheirs resolves a conflict by taking their side.
+func ResolveTheirs[E any](base, ours, theirs []E) []E {
+	return theirs
+}
+
+// ResolveBoth resolves a conflict by taking our side followed by their side.
+func ResolveBoth[E any](base, ours, theirs []E) []E {
+	return Concat(ours, theirs)
+}
+
+// Merge3 merges the changes made to base in ours and theirs, and returns the merged slice along with
+// the conflicting regions. A region changed on only one side, or changed identically on both sides,
+// is merged cleanly. A region changed differently on both sides is a conflict and is replaced by the
+// result of resolve; if resolve is nil, ResolveOurs is used.
+func Merge3[S ~[]E, E comparable](base, ours, theirs S, resolve ConflictResolver[E]) (S, []Conflict[E]) {
+	if resolve == nil {
+		resolve = ResolveOurs[E]
+	}
+
+	matchOurs := matchDiff(base, ours)
+	matchTheirs := matchDiff(base, theirs)
+
+	var merged S
+	var conflicts []Conflict[E]
+	i, j, k := 0, 0, 0
+
+	for i < len(base) || j < len(ours) || k < len(theirs) {
+		if i < len(base) && matchOurs[i] == j && matchTheirs[i] == k {
+			merged = append(merged, base[i])
+			i, j, k = i+1, j+1, k+1
+			continue
+		}
+
+		ni, nj, nk := len(base), len(ours), len(theirs)
+		for x := i; x < len(base); x++ {
+			if matchOurs[x] >= 0 && matchTheirs[x] >= 0 {
+				ni, nj, nk = x, matchOurs[x], matchTheirs[x]
+				break
+			}
+		}
+
+		b, o, t := base[i:ni], ours[j:nj], theirs[k:nk]
+		switch {
+		case Equals(o, b):
+			merged = append(merged, t...)
+		case Equals(t, b), Equals(o, t):
+			merged = append(merged, o...)
+		default:
+			start := len(merged)
+			merged = append(merged, resolve(Clone(b), Clone(o), Clone(t))...)
+			conflicts = append(conflicts, Conflict[E]{
+				Base:      Clone(b),
+				Ours:      Clone(o),
+				Theirs:    Clone(t),
+				BaseStart: i,
+				Start:     start,
+				End:       len(merged),
+			})
+		}
+
+		i, j, k = ni, nj, nk
+	}
+
+	return merged, conflicts
+}
+
+// matchDiff returns, for every index of a, the index of the matching element in b, or -1 if the
+// element is not kept by Diff(a, b).
+func matchDiff[S ~[]E, E comparable](a, b S) []int {
+	m := make([]int, len(a))
+	Fill(m, -1)
+
+	for _, e := range Diff(a, b) {
+		if e.Op == EditKeep {
+			m[e.A] = e.B
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,51 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func TestMerge3(t *testing.T) {
+	testCases := []struct {
+		base, ours, theirs []int
+		resolve            slice.ConflictResolver[int]
+		want               []int
+		conflicts          int
+	}{
+		{[]int{1, 2, 3}, []int{1, 2, 3}, []int{1, 2, 3}, nil, []int{1, 2, 3}, 0},
+		{[]int{1, 2, 3}, []int{0, 1, 2, 3}, []int{1, 2, 3, 4}, nil, []int{0, 1, 2, 3, 4}, 0},
+		{[]int{1, 2, 3}, []int{1, 3}, []int{1, 2, 3, 4}, nil, []int{1, 3, 4}, 0},
+		{[]int{1, 2, 3}, []int{1, 5, 3}, []int{1, 5, 3}, nil, []int{1, 5, 3}, 0},
+		{[]int{1, 2, 3}, []int{1, 5, 3}, []int{1, 6, 3}, nil, []int{1, 5, 3}, 1},
+		{[]int{1, 2, 3}, []int{1, 5, 3}, []int{1, 6, 3}, slice.ResolveTheirs[int], []int{1, 6, 3}, 1},
+		{[]int{1, 2, 3}, []int{1, 5, 3}, []int{1, 6, 3}, slice.ResolveBoth[int], []int{1, 5, 6, 3}, 1},
+		{nil, []int{1}, []int{2}, slice.ResolveBoth[int], []int{1, 2}, 1},
+		{nil, nil, nil, nil, nil, 0},
+	}
+
+	for _, c := range testCases {
+		if r, conflicts := slice.Merge3(c.base, c.ours, c.theirs, c.resolve); !slice.Equals(r, c.want) || len(conflicts) != c.conflicts {
+			t.Errorf("Merge3(%v, %v, %v) = %v, %v, want %v with %v conflicts", c.base, c.ours, c.theirs, r, conflicts, c.want, c.conflicts)
+		}
+	}
+}
+
+func TestMerge3Conflict(t *testing.T) {
+	base := []int{1, 2, 3, 4}
+	ours := []int{1, 5, 3, 4}
+	theirs := []int{1, 6, 7, 3, 4}
+
+	_, conflicts := slice.Merge3(base, ours, theirs, func(base, ours, theirs []int) []int {
+		return []int{0, 0, 0}
+	})
+	if len(conflicts) != 1 {
+		t.Fatalf("Merge3(%v, %v, %v) has %v conflicts, want 1", base, ours, theirs, len(conflicts))
+	}
+
+	c := conflicts[0]
+	if !slice.Equals(c.Base, []int{2}) || !slice.Equals(c.Ours, []int{5}) || !slice.Equals(c.Theirs, []int{6, 7}) ||
+		c.BaseStart != 1 || c.Start != 1 || c.End != 4 {
+		t.Errorf("Merge3(%v, %v, %v) conflict = %+v", base, ours, theirs, c)
+	}
+}
@@ -0,0 +1,140 @@
+package slice
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DedupFold returns a new slice containing only the first of the elements of s that are equal
+// under Unicode case folding.
+func DedupFold[S ~[]E, E ~string](s S) S {
+	if len(s) == 0 {
+		return nil
+	}
+
+	n := make(S, 0, len(s))
+	m := make(map[string]bool)
+
+	for _, v := range s {
+		if k := foldString(string(v)); !m[k] {
+			n = append(n, v)
+			m[k] = true
+		}
+	}
+
+	return n
+}
+
+// NaturalLess returns true if a orders before b in natural order, in which runs of ASCII digits are
+// compared by their numeric value, so that "file2" orders before "file10".
+func NaturalLess[E ~string](a, b E) bool {
+	return naturalCompare(string(a), string(b), false) < 0
+}
+
+// NaturalLessFold is like NaturalLess, but compares letters under Unicode case folding.
+func NaturalLessFold[E ~string](a, b E) bool {
+	return naturalCompare(string(a), string(b), true) < 0
+}
+
+// NaturalSort sorts the elements of s in natural order, as defined by NaturalLess.
+func NaturalSort[S ~[]E, E ~string](s S) {
+	Sort(s, NaturalLess[E])
+}
+
+// NaturalSortFold sorts the elements of s in natural order under Unicode case folding,
+// as defined by NaturalLessFold.
+func NaturalSortFold[S ~[]E, E ~string](s S) {
+	Sort(s, NaturalLessFold[E])
+}
+
+// SortFold sorts the elements of s in increasing order under Unicode case folding.
+// Strings that are equal under case folding are ordered by their bytes.
+func SortFold[S ~[]E, E ~string](s S) {
+	Sort(s, func(a, b E) bool {
+		if r := foldCompare(string(a), string(b)); r != 0 {
+			return r < 0
+		}
+		return a < b
+	})
+}
+
+// foldCompare compares a and b rune by rune under Unicode case folding.
+func foldCompare(a, b string) int {
+	for a != "" && b != "" {
+		ra, na := utf8.DecodeRuneInString(a)
+		rb, nb := utf8.DecodeRuneInString(b)
+		if r := compare(foldRune(ra), foldRune(rb)); r != 0 {
+			return r
+		}
+		a, b = a[na:], b[nb:]
+	}
+
+	return compare(len(a), len(b))
+}
+
+// foldRune returns the smallest rune equivalent to r under Unicode simple case folding.
+func foldRune(r rune) rune {
+	lowest := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < lowest {
+			lowest = f
+		}
+	}
+	return lowest
+}
+
+func foldString(s string) string {
+	return strings.Map(foldRune, s)
+}
+
+// naturalCompare compares a and b in natural order, optionally under Unicode case folding.
+// Strings that compare equal are ordered by their bytes, so the order is total.
+func naturalCompare(a, b string, fold bool) int {
+	ra, rb := a, b
+
+	for a != "" && b != "" {
+		if isDigit(a[0]) && isDigit(b[0]) {
+			da, db := digitRun(a), digitRun(b)
+			na, nb := strings.TrimLeft(da, "0"), strings.TrimLeft(db, "0")
+
+			if r := compare(len(na), len(nb)); r != 0 {
+				return r
+			}
+			if r := strings.Compare(na, nb); r != 0 {
+				return r
+			}
+
+			a, b = a[len(da):], b[len(db):]
+			continue
+		}
+
+		ca, na := utf8.DecodeRuneInString(a)
+		cb, nb := utf8.DecodeRuneInString(b)
+		if fold {
+			ca, cb = foldRune(ca), foldRune(cb)
+		}
+		if r := compare(ca, cb); r != 0 {
+			return r
+		}
+		a, b = a[na:], b[nb:]
+	}
+
+	if r := compare(len(a), len(b)); r != 0 {
+		return r
+	}
+	return strings.Compare(ra, rb)
+}
+
+// digitRun returns the leading run of ASCII digits of s.
+func digitRun(s string) string {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return s[:i]
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
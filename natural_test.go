@@ -0,0 +1,76 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func TestDedupFold(t *testing.T) {
+	testCases := []struct {
+		s    []string
+		want []string
+	}{
+		{[]string{"Go", "go", "GO", "rust"}, []string{"Go", "rust"}},
+		{[]string{"STRASSE", "strasse", "Straße"}, []string{"STRASSE", "Straße"}},
+		{[]string{"Σίσυφος", "ΣΊΣΥΦΟΣ", "σίσυφοσ"}, []string{"Σίσυφος"}},
+		{nil, nil},
+	}
+
+	for _, c := range testCases {
+		if r := slice.DedupFold(c.s); !slice.Equals(r, c.want) {
+			t.Errorf("DedupFold(%v) = %v, want %v", c.s, r, c.want)
+		}
+	}
+}
+
+func TestNaturalLess(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want bool
+	}{
+		{"file2", "file10", true},
+		{"file10", "file2", false},
+		{"file02", "file2", true},
+		{"file2", "file02", false},
+		{"a1b2", "a1b10", true},
+		{"a", "a1", true},
+		{"B", "a", true},
+		{"x", "x", false},
+	}
+
+	for _, c := range testCases {
+		if r := slice.NaturalLess(c.a, c.b); r != c.want {
+			t.Errorf("NaturalLess(%q, %q) = %v, want %v", c.a, c.b, r, c.want)
+		}
+	}
+}
+
+func TestNaturalSort(t *testing.T) {
+	s := []string{"file10.txt", "file2.txt", "File1.txt", "file1.txt", "file20.txt"}
+	want := []string{"File1.txt", "file1.txt", "file2.txt", "file10.txt", "file20.txt"}
+
+	if slice.NaturalSort(s); !slice.Equals(s, want) {
+		t.Errorf("got %v, want %v", s, want)
+	}
+}
+
+func TestNaturalSortFold(t *testing.T) {
+	type name string
+
+	s := []name{"img12", "IMG3", "Img1", "img3", "beta"}
+	want := []name{"beta", "Img1", "IMG3", "img3", "img12"}
+
+	if slice.NaturalSortFold(s); !slice.Equals(s, want) {
+		t.Errorf("got %v, want %v", s, want)
+	}
+}
+
+func TestSortFold(t *testing.T) {
+	s := []string{"banana", "Apple", "cherry", "apple", "Äpfel"}
+	want := []string{"Apple", "apple", "banana", "cherry", "Äpfel"}
+
+	if slice.SortFold(s); !slice.Equals(s, want) {
+		t.Errorf("got %v, want %v", s, want)
+	}
+}
@@ -0,0 +1,86 @@
+package slice
+
+import (
+	"runtime"
+	"sync"
+)
+
+const (
+	// parallelSortThreshold is the length below which ParallelSort and ParallelSortStable sort sequentially.
+	parallelSortThreshold = 1 << 13
+
+	// parallelSortMinChunk is the smallest chunk handed to a worker; the number of workers is capped
+	// so that no chunk is shorter.
+	parallelSortMinChunk = parallelSortThreshold / 8
+)
+
+// ParallelSort sorts the elements of slice s in increasing order, according to the given less
+// function, using the given number of goroutines. The slice is split into chunks that are sorted
+// concurrently and then merged. If workers <= 0, runtime.GOMAXPROCS(0) is used. The number of
+// workers is capped so that each chunk holds at least 1024 elements, and small slices are sorted
+// sequentially with Sort. The sort is not guaranteed to be stable.
+func ParallelSort[S ~[]E, E any](s S, less func(a, b E) bool, workers int) {
+	parallelSort(s, less, workers, Sort[S, E])
+}
+
+// ParallelSortStable is like ParallelSort, but keeps equal elements in their original order.
+// Its result is identical to that of SortStable.
+func ParallelSortStable[S ~[]E, E any](s S, less func(a, b E) bool, workers int) {
+	parallelSort(s, less, workers, SortStable[S, E])
+}
+
+func parallelSort[S ~[]E, E any](s S, less func(a, b E) bool, workers int, sortFunc func(S, func(a, b E) bool)) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = minInt(workers, len(s)/parallelSortMinChunk)
+	if workers <= 1 || len(s) < parallelSortThreshold {
+		sortFunc(s, less)
+		return
+	}
+
+	bounds := make([]int, workers+1)
+	for i := range bounds {
+		bounds[i] = i * len(s) / workers
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(chunk S) {
+			defer wg.Done()
+			sortFunc(chunk, less)
+		}(s[bounds[i]:bounds[i+1]])
+	}
+	wg.Wait()
+
+	src, dst := s, make(S, len(s))
+	for len(bounds) > 2 {
+		next := make([]int, 0, len(bounds)/2+1)
+
+		for i := 0; i+1 < len(bounds); i += 2 {
+			lo := bounds[i]
+			next = append(next, lo)
+
+			if i+2 >= len(bounds) {
+				copy(dst[lo:], src[lo:bounds[i+1]])
+				continue
+			}
+
+			mid, hi := bounds[i+1], bounds[i+2]
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				mergeInto(dst[lo:hi], src[lo:mid], src[mid:hi], less)
+			}()
+		}
+		wg.Wait()
+
+		bounds = append(next, len(s))
+		src, dst = dst, src
+	}
+
+	if &src[0] != &s[0] {
+		copy(s, src)
+	}
+}
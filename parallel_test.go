@@ -0,0 +1,52 @@
+package slice_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+type keyed struct {
+	key, seq int
+}
+
+func randomKeyed(n int) []keyed {
+	r := rand.New(rand.NewSource(int64(n)))
+	s := make([]keyed, n)
+	for i := range s {
+		s[i] = keyed{r.Intn(100), i}
+	}
+	return s
+}
+
+func lessKeyed(a, b keyed) bool { return a.key < b.key }
+
+func TestParallelSort(t *testing.T) {
+	for _, n := range []int{0, 100, 9000, 50000} {
+		for _, workers := range []int{0, 1, 3, 8, 1 << 20} {
+			s := randomKeyed(n)
+			want := slice.Map(slice.Clone(s), func(v keyed) int { return v.key })
+			slice.Sort(want, lessInt)
+
+			slice.ParallelSort(s, lessKeyed, workers)
+			if r := slice.Map(s, func(v keyed) int { return v.key }); !slice.Equals(r, want) {
+				t.Errorf("ParallelSort(n=%v, workers=%v) is not sorted", n, workers)
+			}
+		}
+	}
+}
+
+func TestParallelSortStable(t *testing.T) {
+	for _, n := range []int{0, 100, 9000, 50000} {
+		for _, workers := range []int{0, 1, 3, 8, 1 << 20} {
+			s := randomKeyed(n)
+			want := slice.Clone(s)
+			slice.SortStable(want, lessKeyed)
+
+			if slice.ParallelSortStable(s, lessKeyed, workers); !slice.Equals(s, want) {
+				t.Errorf("ParallelSortStable(n=%v, workers=%v) differs from SortStable", n, workers)
+			}
+		}
+	}
+}
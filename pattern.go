@@ -0,0 +1,290 @@
+package slice
+
+// Pattern is a regular-expression-like pattern over slices of E. Patterns are built from
+// predicate atoms with PatternAtom, combined with PatternSeq, PatternAlt, PatternStar, PatternPlus,
+// PatternOptional and PatternCapture, and compiled with CompilePattern.
+type Pattern[E any] struct {
+	kind     patternKind
+	pred     func(E) bool
+	children []*Pattern[E]
+}
+
+type patternKind int
+
+const (
+	patternKindAtom patternKind = iota
+	patternKindSeq
+	patternKindAlt
+	patternKindStar
+	patternKindPlus
+	patternKindOptional
+	patternKindCapture
+)
+
+// PatternAtom returns a pattern that matches a single element satisfying f.
+func PatternAtom[E any](f func(E) bool) *Pattern[E] {
+	return &Pattern[E]{kind: patternKindAtom, pred: f}
+}
+
+// PatternSeq returns a pattern that matches the given patterns one after another.
+// An empty sequence matches the empty slice.
+func PatternSeq[E any](ps ...*Pattern[E]) *Pattern[E] {
+	return &Pattern[E]{kind: patternKindSeq, children: ps}
+}
+
+// PatternAlt returns a pattern that matches any of the given patterns, preferring earlier ones.
+// An empty alternation never matches.
+func PatternAlt[E any](ps ...*Pattern[E]) *Pattern[E] {
+	return &Pattern[E]{kind: patternKindAlt, children: ps}
+}
+
+// PatternStar returns a pattern that greedily matches zero or more repetitions of p.
+func PatternStar[E any](p *Pattern[E]) *Pattern[E] {
+	return &Pattern[E]{kind: patternKindStar, children: []*Pattern[E]{p}}
+}
+
+// PatternPlus returns a pattern that greedily matches one or more repetitions of p.
+func PatternPlus[E any](p *Pattern[E]) *Pattern[E] {
+	return &Pattern[E]{kind: patternKindPlus, children: []*Pattern[E]{p}}
+}
+
+// PatternOptional returns a pattern that greedily matches zero or one occurrence of p.
+func PatternOptional[E any](p *Pattern[E]) *Pattern[E] {
+	return &Pattern[E]{kind: patternKindOptional, children: []*Pattern[E]{p}}
+}
+
+// PatternCapture returns a pattern that matches p and records the matched range as a capture group.
+// Groups are numbered from 1 in the order their PatternCapture appears in the pattern; group 0 is the whole match.
+func PatternCapture[E any](p *Pattern[E]) *Pattern[E] {
+	return &Pattern[E]{kind: patternKindCapture, children: []*Pattern[E]{p}}
+}
+
+type instOp int
+
+const (
+	instAtom instOp = iota
+	instSplit
+	instJmp
+	instSave
+	instMatch
+	instFail
+)
+
+type inst[E any] struct {
+	op   instOp
+	pred func(E) bool
+	x, y int
+}
+
+// Matcher is a compiled Pattern. It simulates the pattern's NFA, so matching runs in
+// O(len(s) * size of the pattern) time. A Matcher is safe for concurrent use.
+type Matcher[E any] struct {
+	prog  []inst[E]
+	ncaps int
+}
+
+// CompilePattern compiles p into a Matcher.
+func CompilePattern[E any](p *Pattern[E]) *Matcher[E] {
+	m := &Matcher[E]{ncaps: 1}
+	m.emit(inst[E]{op: instSave, x: 0})
+	m.compile(p)
+	m.emit(inst[E]{op: instSave, x: 1})
+	m.emit(inst[E]{op: instMatch})
+	return m
+}
+
+func (m *Matcher[E]) emit(i inst[E]) int {
+	m.prog = append(m.prog, i)
+	return len(m.prog) - 1
+}
+
+func (m *Matcher[E]) compile(p *Pattern[E]) {
+	switch p.kind {
+	case patternKindAtom:
+		m.emit(inst[E]{op: instAtom, pred: p.pred})
+
+	case patternKindSeq:
+		for _, c := range p.children {
+			m.compile(c)
+		}
+
+	case patternKindAlt:
+		if len(p.children) == 0 {
+			m.emit(inst[E]{op: instFail})
+			return
+		}
+
+		var jumps []int
+		for i, c := range p.children {
+			if i == len(p.children)-1 {
+				m.compile(c)
+				break
+			}
+
+			split := m.emit(inst[E]{op: instSplit})
+			m.prog[split].x = len(m.prog)
+			m.compile(c)
+			jumps = append(jumps, m.emit(inst[E]{op: instJmp}))
+			m.prog[split].y = len(m.prog)
+		}
+		for _, j := range jumps {
+			m.prog[j].x = len(m.prog)
+		}
+
+	case patternKindStar:
+		split := m.emit(inst[E]{op: instSplit})
+		m.prog[split].x = len(m.prog)
+		m.compile(p.children[0])
+		m.emit(inst[E]{op: instJmp, x: split})
+		m.prog[split].y = len(m.prog)
+
+	case patternKindPlus:
+		start := len(m.prog)
+		m.compile(p.children[0])
+		m.emit(inst[E]{op: instSplit, x: start, y: len(m.prog) + 1})
+
+	case patternKindOptional:
+		split := m.emit(inst[E]{op: instSplit})
+		m.prog[split].x = len(m.prog)
+		m.compile(p.children[0])
+		m.prog[split].y = len(m.prog)
+
+	case patternKindCapture:
+		n := m.ncaps
+		m.ncaps++
+		m.emit(inst[E]{op: instSave, x: 2 * n})
+		m.compile(p.children[0])
+		m.emit(inst[E]{op: instSave, x: 2*n + 1})
+	}
+}
+
+// NumCaptures returns the number of capture groups in the pattern, not counting group 0.
+func (m *Matcher[E]) NumCaptures() int {
+	return m.ncaps - 1
+}
+
+// Match returns true if the whole of s matches the pattern.
+func (m *Matcher[E]) Match(s []E) bool {
+	return m.run(s, 0, true) != nil
+}
+
+// Find returns the leftmost match of the pattern in s as pairs of indices: loc[2*i:2*i+2] is the
+// range of s matched by group i, or -1, -1 if the group did not participate in the match.
+// It returns nil if there is no match.
+func (m *Matcher[E]) Find(s []E) []int {
+	return m.run(s, 0, false)
+}
+
+// FindAll returns successive non-overlapping matches of the pattern in s, in the form returned by Find.
+// If n >= 0, at most n matches are returned. Empty matches abutting a preceding match are ignored.
+func (m *Matcher[E]) FindAll(s []E, n int) [][]int {
+	var r [][]int
+	prevEnd := -1
+
+	for pos := 0; pos <= len(s) && (n < 0 || len(r) < n); {
+		loc := m.run(s, pos, false)
+		if loc == nil {
+			break
+		}
+
+		if loc[0] == loc[1] {
+			pos = loc[1] + 1
+			if loc[0] == prevEnd {
+				continue
+			}
+		} else {
+			pos = loc[1]
+		}
+
+		prevEnd = loc[1]
+		r = append(r, loc)
+	}
+
+	return r
+}
+
+// ReplaceAllFunc returns a copy of s in which all matches found by FindAll have been replaced by
+// the return value of f applied to the matched subslice.
+func (m *Matcher[E]) ReplaceAllFunc(s []E, f func([]E) []E) []E {
+	var r []E
+	var last int
+
+	for _, loc := range m.FindAll(s, -1) {
+		r = append(r, s[last:loc[0]]...)
+		r = append(r, f(s[loc[0]:loc[1]:loc[1]])...)
+		last = loc[1]
+	}
+
+	return append(r, s[last:]...)
+}
+
+type patternThread struct {
+	pc   int
+	caps []int
+}
+
+// run simulates the program on s starting at index start with leftmost-first semantics, and returns
+// the capture positions of the preferred match. If full is true, the match must span all of s[start:].
+func (m *Matcher[E]) run(s []E, start int, full bool) []int {
+	seen := make([]int, len(m.prog))
+	Fill(seen, -1)
+
+	var clist, nlist []patternThread
+	var matched []int
+
+	for pos := start; pos <= len(s); pos++ {
+		if matched == nil && (pos == start || !full) {
+			caps := make([]int, 2*m.ncaps)
+			Fill(caps, -1)
+			clist = m.addThread(clist, seen, 0, caps, pos)
+		}
+		if len(clist) == 0 {
+			break
+		}
+
+		nlist = nlist[:0]
+	threads:
+		for _, t := range clist {
+			in := m.prog[t.pc]
+			switch in.op {
+			case instAtom:
+				if pos < len(s) && in.pred(s[pos]) {
+					nlist = m.addThread(nlist, seen, t.pc+1, t.caps, pos+1)
+				}
+			case instMatch:
+				if !full || pos == len(s) {
+					matched = t.caps
+					break threads
+				}
+			}
+		}
+		clist, nlist = nlist, clist
+	}
+
+	return matched
+}
+
+// addThread adds the thread at pc to list, following jumps, splits and saves in priority order.
+// seen records the position at which each instruction was last added, to drop duplicate threads.
+func (m *Matcher[E]) addThread(list []patternThread, seen []int, pc int, caps []int, pos int) []patternThread {
+	if seen[pc] == pos {
+		return list
+	}
+	seen[pc] = pos
+
+	switch in := m.prog[pc]; in.op {
+	case instJmp:
+		return m.addThread(list, seen, in.x, caps, pos)
+	case instSplit:
+		list = m.addThread(list, seen, in.x, caps, pos)
+		return m.addThread(list, seen, in.y, caps, pos)
+	case instSave:
+		caps = Clone(caps)
+		caps[in.x] = pos
+		return m.addThread(list, seen, pc+1, caps, pos)
+	case instFail:
+		return list
+	}
+
+	return append(list, patternThread{pc, caps})
+}
@@ -0,0 +1,103 @@
+package slice_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func is(v string) *slice.Pattern[string] {
+	return slice.PatternAtom(func(e string) bool { return e == v })
+}
+
+func TestMatcherMatch(t *testing.T) {
+	m := slice.CompilePattern(slice.PatternSeq(is("a"), slice.PatternPlus(is("b")), slice.PatternOptional(is("c"))))
+
+	testCases := []struct {
+		s    string
+		want bool
+	}{
+		{"ab", true},
+		{"abbbc", true},
+		{"abc", true},
+		{"ac", false},
+		{"abcc", false},
+		{"xab", false},
+		{"", false},
+	}
+
+	for _, c := range testCases {
+		if r := m.Match(strings.Split(c.s, "")); r != c.want {
+			t.Errorf("Match(%q) = %v, want %v", c.s, r, c.want)
+		}
+	}
+
+	empty := slice.CompilePattern(slice.PatternStar(slice.PatternOptional(is("a"))))
+	if !empty.Match(nil) || !empty.Match([]string{"a", "a"}) {
+		t.Errorf("Match of nested empty loop failed")
+	}
+}
+
+func TestMatcherFind(t *testing.T) {
+	testCases := []struct {
+		p    *slice.Pattern[string]
+		s    string
+		want []int
+	}{
+		{slice.PatternPlus(is("b")), "abbc", []int{1, 3}},
+		{slice.PatternSeq(is("a"), slice.PatternCapture(slice.PatternPlus(is("b"))), slice.PatternOptional(slice.PatternCapture(is("c")))), "xabbd", []int{1, 4, 2, 4, -1, -1}},
+		{slice.PatternSeq(is("a"), slice.PatternCapture(slice.PatternPlus(is("b"))), slice.PatternOptional(slice.PatternCapture(is("c")))), "abc", []int{0, 3, 1, 2, 2, 3}},
+		{slice.PatternAlt(is("a"), slice.PatternSeq(is("a"), is("b"))), "ab", []int{0, 1}},
+		{slice.PatternAlt(slice.PatternSeq(is("a"), is("b")), is("a")), "ab", []int{0, 2}},
+		{slice.PatternStar(is("a")), "bbb", []int{0, 0}},
+		{slice.PatternAlt[string](), "abc", nil},
+		{is("z"), "abc", nil},
+	}
+
+	for _, c := range testCases {
+		if r := slice.CompilePattern(c.p).Find(strings.Split(c.s, "")); !slice.Equals(r, c.want) {
+			t.Errorf("Find(%q) = %v, want %v", c.s, r, c.want)
+		}
+	}
+}
+
+func TestMatcherFindAll(t *testing.T) {
+	testCases := []struct {
+		p    *slice.Pattern[string]
+		s    string
+		n    int
+		want [][]int
+	}{
+		{slice.PatternPlus(is("a")), "aabaaa", -1, [][]int{{0, 2}, {3, 6}}},
+		{slice.PatternPlus(is("a")), "aabaaa", 1, [][]int{{0, 2}}},
+		{slice.PatternStar(is("a")), "baab", -1, [][]int{{0, 0}, {1, 3}, {4, 4}}},
+		{is("z"), "abc", -1, nil},
+	}
+
+	for _, c := range testCases {
+		if r := slice.CompilePattern(c.p).FindAll(strings.Split(c.s, ""), c.n); !equals2D(r, c.want) {
+			t.Errorf("FindAll(%q, %v) = %v, want %v", c.s, c.n, r, c.want)
+		}
+	}
+}
+
+func TestMatcherNumCaptures(t *testing.T) {
+	m := slice.CompilePattern(slice.PatternSeq(slice.PatternCapture(is("a")), slice.PatternCapture(slice.PatternCapture(is("b")))))
+	if r := m.NumCaptures(); r != 3 {
+		t.Errorf("NumCaptures() = %v, want 3", r)
+	}
+}
+
+func TestMatcherReplaceAllFunc(t *testing.T) {
+	m := slice.CompilePattern(slice.PatternSeq(is("a"), slice.PatternPlus(is("b"))))
+	s := strings.Split("xabbyabz", "")
+	want := strings.Split("x2y1z", "")
+
+	r := m.ReplaceAllFunc(s, func(match []string) []string {
+		return []string{string(rune('0' + len(match) - 1))}
+	})
+	if !slice.Equals(r, want) {
+		t.Errorf("ReplaceAllFunc(%v) = %v, want %v", s, r, want)
+	}
+}
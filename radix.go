@@ -0,0 +1,156 @@
+package slice
+
+import "golang.org/x/exp/constraints"
+
+// radixSort uses radixSmallDigitBits-bit digits, whose counts fit in the L1 cache, and switches to
+// radixLargeDigitBits-bit digits, which need fewer passes, once the slice is long enough to
+// amortize the larger count arrays.
+const (
+	radixSmallDigitBits = 11
+	radixLargeDigitBits = 16
+	radixLargeMin       = 1 << 17
+)
+
+// SortIntegers sorts a slice of integers in increasing order. It uses a counting sort when the
+// range of values is no larger than the slice, and an LSD radix sort otherwise, so it runs in
+// O(len(s)) time for a fixed integer size. Small slices are sorted with Sort.
+func SortIntegers[S ~[]E, E constraints.Integer](s S) {
+	if len(s) < 256 {
+		Sort(s, func(a, b E) bool { return a < b })
+		return
+	}
+
+	lo, hi := s[0], s[0]
+	for _, v := range s[1:] {
+		if v < lo {
+			lo = v
+		} else if v > hi {
+			hi = v
+		}
+	}
+
+	sign, mask := integerSignBit[E](), integerMask[E]()
+	base := (uint64(lo) ^ sign) & mask
+	if rng := (uint64(hi)^sign)&mask - base; rng < uint64(len(s)) {
+		counts := make([]int, rng+1)
+		for _, v := range s {
+			counts[(uint64(v)^sign)&mask-base]++
+		}
+
+		var i int
+		for d, c := range counts {
+			v := lo + E(d)
+			for ; c > 0; c-- {
+				s[i] = v
+				i++
+			}
+		}
+		return
+	}
+
+	radixSort[E, E](s, nil)
+}
+
+// SortIntegersBy sorts the elements of s in increasing order of the integer keys returned by the
+// key function, using an LSD radix sort. The key is computed once per element, and the sort is stable.
+func SortIntegersBy[S ~[]E, E any, K constraints.Integer](s S, key func(E) K) {
+	radixSort(Map(s, key), []E(s))
+}
+
+// radixSort stably sorts keys in increasing order, one digit per pass. If s is not nil, its elements
+// are permuted along with the keys. The counts for every pass are gathered in a single read of the
+// keys, and passes in which every key has the same digit are skipped.
+func radixSort[K constraints.Integer, E any](keys []K, s []E) {
+	n := len(keys)
+	if n == 0 {
+		return
+	}
+
+	digitBits := radixSmallDigitBits
+	if n >= radixLargeMin {
+		digitBits = radixLargeDigitBits
+	}
+	buckets := 1 << digitBits
+	digitMask := uint64(buckets - 1)
+
+	sign, mask := integerSignBit[K](), integerMask[K]()
+	passes := (integerBits[K]() + digitBits - 1) / digitBits
+
+	counts := make([]int, passes*buckets)
+	for _, k := range keys {
+		u := (uint64(k) ^ sign) & mask
+		for p := 0; p < passes; p++ {
+			counts[p*buckets+int(u>>(p*digitBits)&digitMask)]++
+		}
+	}
+
+	origKeys, origS := keys, s
+	keyBuf := make([]K, n)
+	var buf []E
+	if s != nil {
+		buf = make([]E, n)
+	}
+
+	for p := 0; p < passes; p++ {
+		c := counts[p*buckets : (p+1)*buckets]
+		shift := p * digitBits
+		if c[((uint64(keys[0])^sign)&mask)>>shift&digitMask] == n {
+			continue
+		}
+
+		var sum int
+		for i, v := range c {
+			c[i] = sum
+			sum += v
+		}
+
+		if s == nil {
+			for _, k := range keys {
+				d := ((uint64(k) ^ sign) & mask) >> shift & digitMask
+				keyBuf[c[d]] = k
+				c[d]++
+			}
+		} else {
+			for i, k := range keys {
+				d := ((uint64(k) ^ sign) & mask) >> shift & digitMask
+				keyBuf[c[d]] = k
+				buf[c[d]] = s[i]
+				c[d]++
+			}
+		}
+
+		keys, keyBuf = keyBuf, keys
+		s, buf = buf, s
+	}
+
+	if &keys[0] != &origKeys[0] {
+		copy(origKeys, keys)
+		copy(origS, s)
+	}
+}
+
+// integerBits returns the size of E in bits.
+func integerBits[E constraints.Integer]() int {
+	bits := 8
+	for E(1)<<bits != 0 {
+		bits += 8
+	}
+	return bits
+}
+
+// integerSignBit returns the sign bit of E as a uint64, or 0 if E is unsigned.
+func integerSignBit[E constraints.Integer]() uint64 {
+	var m E
+	if m--; m > 0 {
+		return 0
+	}
+	return 1 << (integerBits[E]() - 1)
+}
+
+// integerMask returns a mask of the low integerBits[E]() bits of a uint64.
+func integerMask[E constraints.Integer]() uint64 {
+	if bits := integerBits[E](); bits < 64 {
+		return 1<<bits - 1
+	}
+	return ^uint64(0)
+}
@@ -0,0 +1,143 @@
+package slice_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func TestSortIntegers(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, n := range []int{0, 10, 1000, 10000, 200000} {
+		for _, max := range []int64{10, 1 << 40} {
+			s := make([]int64, n)
+			for i := range s {
+				s[i] = r.Int63n(2*max) - max
+			}
+			want := slice.Clone(s)
+			slice.Sort(want, func(a, b int64) bool { return a < b })
+
+			if slice.SortIntegers(s); !slice.Equals(s, want) {
+				t.Errorf("SortIntegers(n=%v, max=%v) is not sorted", n, max)
+			}
+		}
+	}
+
+	s8 := []int8{math.MaxInt8, math.MinInt8, -1, 0, 1}
+	s8 = slice.Concat(s8, s8, s8, s8, s8, s8, s8, s8)
+	s8 = slice.Concat(s8, s8, s8, s8, s8, s8, s8, s8)
+	if slice.SortIntegers(s8); !slice.IsSorted(s8) || s8[0] != math.MinInt8 || s8[len(s8)-1] != math.MaxInt8 {
+		t.Errorf("SortIntegers([]int8) is not sorted")
+	}
+
+	u32 := make([]uint32, 1000)
+	for i := range u32 {
+		u32[i] = r.Uint32()
+	}
+	if slice.SortIntegers(u32); !slice.IsSorted(u32) {
+		t.Errorf("SortIntegers([]uint32) is not sorted")
+	}
+}
+
+func TestSortIntegersBy(t *testing.T) {
+	type record struct {
+		id  int32
+		seq int
+	}
+
+	r := rand.New(rand.NewSource(2))
+	for _, n := range []int{5000, 200000} {
+		s := make([]record, n)
+		for i := range s {
+			s[i] = record{int32(r.Intn(2*n) - n), i}
+		}
+		want := slice.Clone(s)
+		slice.SortStable(want, func(a, b record) bool { return a.id < b.id })
+
+		if slice.SortIntegersBy(s, func(v record) int32 { return v.id }); !slice.Equals(s, want) {
+			t.Errorf("SortIntegersBy(n=%v) is not a stable sort", n)
+		}
+	}
+}
+
+func benchmarkInts(n int) []int {
+	r := rand.New(rand.NewSource(1))
+	s := make([]int, n)
+	for i := range s {
+		s[i] = r.Int()
+	}
+	return s
+}
+
+func BenchmarkSortIntegers(b *testing.B) {
+	s := benchmarkInts(1 << 20)
+	c := make([]int, len(s))
+
+	for i := 0; i < b.N; i++ {
+		copy(c, s)
+		slice.SortIntegers(c)
+	}
+}
+
+func BenchmarkSortIntegersSort(b *testing.B) {
+	s := benchmarkInts(1 << 20)
+	c := make([]int, len(s))
+
+	for i := 0; i < b.N; i++ {
+		copy(c, s)
+		slice.Sort(c, func(a, b int) bool { return a < b })
+	}
+}
+
+func BenchmarkSortIntegersInt64(b *testing.B) {
+	s := slice.Map(benchmarkInts(1<<20), func(v int) int64 { return int64(v) - math.MaxInt64/2 })
+	c := make([]int64, len(s))
+
+	for i := 0; i < b.N; i++ {
+		copy(c, s)
+		slice.SortIntegers(c)
+	}
+}
+
+func BenchmarkSortIntegersInt64Sort(b *testing.B) {
+	s := slice.Map(benchmarkInts(1<<20), func(v int) int64 { return int64(v) - math.MaxInt64/2 })
+	c := make([]int64, len(s))
+
+	for i := 0; i < b.N; i++ {
+		copy(c, s)
+		slice.Sort(c, func(a, b int64) bool { return a < b })
+	}
+}
+
+func BenchmarkSortIntegersUint32(b *testing.B) {
+	s := slice.Map(benchmarkInts(1<<20), func(v int) uint32 { return uint32(v) })
+	c := make([]uint32, len(s))
+
+	for i := 0; i < b.N; i++ {
+		copy(c, s)
+		slice.SortIntegers(c)
+	}
+}
+
+func BenchmarkSortIntegersUint32Sort(b *testing.B) {
+	s := slice.Map(benchmarkInts(1<<20), func(v int) uint32 { return uint32(v) })
+	c := make([]uint32, len(s))
+
+	for i := 0; i < b.N; i++ {
+		copy(c, s)
+		slice.Sort(c, func(a, b uint32) bool { return a < b })
+	}
+}
+
+func BenchmarkSortIntegersBy(b *testing.B) {
+	s := benchmarkInts(1 << 20)
+	c := make([]int, len(s))
+
+	for i := 0; i < b.N; i++ {
+		copy(c, s)
+		slice.SortIntegersBy(c, func(v int) int { return v })
+	}
+}
@@ -0,0 +1,277 @@
+package slice
+
+import "math/bits"
+
+// SegmentTree is a segment tree over a sequence of values combined with an associative operation.
+// It supports point updates and range queries in O(log n) time. The operation need not be commutative.
+type SegmentTree[T any] struct {
+	n        int
+	tree     []T
+	op       func(a, b T) T
+	identity T
+}
+
+// NewSegmentTree returns a SegmentTree over the elements of s, combined with op, whose identity
+// element is identity. It is built in O(len(s)) time.
+func NewSegmentTree[S ~[]T, T any](s S, op func(a, b T) T, identity T) *SegmentTree[T] {
+	n := len(s)
+	tree := make([]T, 2*n)
+	copy(tree[n:], s)
+	for i := n - 1; i > 0; i-- {
+		tree[i] = op(tree[2*i], tree[2*i+1])
+	}
+
+	return &SegmentTree[T]{n, tree, op, identity}
+}
+
+// Get returns the element at index i. It panics if i is out of range.
+func (st *SegmentTree[T]) Get(i int) T {
+	if i < 0 || i >= st.n {
+		panic("slice: SegmentTree index out of range")
+	}
+	return st.tree[st.n+i]
+}
+
+// Len returns the number of elements.
+func (st *SegmentTree[T]) Len() int {
+	return st.n
+}
+
+// Query returns the elements in the range [lo:hi) combined in order with op,
+// or the identity element if the range is empty. It panics if the range is out of bounds.
+func (st *SegmentTree[T]) Query(lo, hi int) T {
+	if lo < 0 || lo > hi || hi > st.n {
+		panic("slice: SegmentTree range out of bounds")
+	}
+	left, right := st.identity, st.identity
+	for lo, hi = lo+st.n, hi+st.n; lo < hi; lo, hi = lo/2, hi/2 {
+		if lo&1 == 1 {
+			left = st.op(left, st.tree[lo])
+			lo++
+		}
+		if hi&1 == 1 {
+			hi--
+			right = st.op(st.tree[hi], right)
+		}
+	}
+	return st.op(left, right)
+}
+
+// Set sets the element at index i to v. It panics if i is out of range.
+func (st *SegmentTree[T]) Set(i int, v T) {
+	if i < 0 || i >= st.n {
+		panic("slice: SegmentTree index out of range")
+	}
+	i += st.n
+	st.tree[i] = v
+	for i /= 2; i > 0; i /= 2 {
+		st.tree[i] = st.op(st.tree[2*i], st.tree[2*i+1])
+	}
+}
+
+// LazySegmentTree is a segment tree that, in addition to the operations of SegmentTree, supports
+// applying an update of type U to every element in a range in O(log n) time, by deferring updates
+// to the subtrees until they are visited.
+type LazySegmentTree[T, U any] struct {
+	n        int
+	tree     []T
+	lazy     []U
+	pending  []bool
+	op       func(a, b T) T
+	identity T
+	apply    func(u U, v T, length int) T
+	compose  func(newer, older U) U
+}
+
+// NewLazySegmentTree returns a LazySegmentTree over the elements of s, combined with op, whose
+// identity element is identity. apply returns the result of applying update u to v, the combined
+// value of length elements. compose returns the single update equivalent to applying older and then newer.
+func NewLazySegmentTree[S ~[]T, T, U any](
+	s S,
+	op func(a, b T) T,
+	identity T,
+	apply func(u U, v T, length int) T,
+	compose func(newer, older U) U,
+) *LazySegmentTree[T, U] {
+	n := len(s)
+	st := &LazySegmentTree[T, U]{
+		n:        n,
+		tree:     make([]T, 4*n),
+		lazy:     make([]U, 4*n),
+		pending:  make([]bool, 4*n),
+		op:       op,
+		identity: identity,
+		apply:    apply,
+		compose:  compose,
+	}
+	if n > 0 {
+		st.build(s, 1, 0, n)
+	}
+	return st
+}
+
+func (st *LazySegmentTree[T, U]) build(s []T, node, lo, hi int) {
+	if hi-lo == 1 {
+		st.tree[node] = s[lo]
+		return
+	}
+
+	mid := (lo + hi) / 2
+	st.build(s, 2*node, lo, mid)
+	st.build(s, 2*node+1, mid, hi)
+	st.tree[node] = st.op(st.tree[2*node], st.tree[2*node+1])
+}
+
+// Get returns the element at index i. It panics if i is out of range.
+func (st *LazySegmentTree[T, U]) Get(i int) T {
+	if i < 0 || i >= st.n {
+		panic("slice: LazySegmentTree index out of range")
+	}
+	return st.Query(i, i+1)
+}
+
+// Len returns the number of elements.
+func (st *LazySegmentTree[T, U]) Len() int {
+	return st.n
+}
+
+// Query returns the elements in the range [lo:hi) combined in order with op,
+// or the identity element if the range is empty. It panics if the range is out of bounds.
+func (st *LazySegmentTree[T, U]) Query(lo, hi int) T {
+	if lo < 0 || lo > hi || hi > st.n {
+		panic("slice: LazySegmentTree range out of bounds")
+	}
+	if lo == hi {
+		return st.identity
+	}
+	return st.query(1, 0, st.n, lo, hi)
+}
+
+func (st *LazySegmentTree[T, U]) query(node, nlo, nhi, lo, hi int) T {
+	if hi <= nlo || nhi <= lo {
+		return st.identity
+	}
+	if lo <= nlo && nhi <= hi {
+		return st.tree[node]
+	}
+
+	st.push(node, nlo, nhi)
+	mid := (nlo + nhi) / 2
+	return st.op(st.query(2*node, nlo, mid, lo, hi), st.query(2*node+1, mid, nhi, lo, hi))
+}
+
+// Set sets the element at index i to v. It panics if i is out of range.
+func (st *LazySegmentTree[T, U]) Set(i int, v T) {
+	if i < 0 || i >= st.n {
+		panic("slice: LazySegmentTree index out of range")
+	}
+	st.set(1, 0, st.n, i, v)
+}
+
+func (st *LazySegmentTree[T, U]) set(node, nlo, nhi, i int, v T) {
+	if nhi-nlo == 1 {
+		st.tree[node] = v
+		return
+	}
+
+	st.push(node, nlo, nhi)
+	if mid := (nlo + nhi) / 2; i < mid {
+		st.set(2*node, nlo, mid, i, v)
+	} else {
+		st.set(2*node+1, mid, nhi, i, v)
+	}
+	st.tree[node] = st.op(st.tree[2*node], st.tree[2*node+1])
+}
+
+// Update applies u to every element in the range [lo:hi). It panics if the range is out of bounds.
+func (st *LazySegmentTree[T, U]) Update(lo, hi int, u U) {
+	if lo < 0 || lo > hi || hi > st.n {
+		panic("slice: LazySegmentTree range out of bounds")
+	}
+	if lo < hi {
+		st.update(1, 0, st.n, lo, hi, u)
+	}
+}
+
+func (st *LazySegmentTree[T, U]) update(node, nlo, nhi, lo, hi int, u U) {
+	if hi <= nlo || nhi <= lo {
+		return
+	}
+	if lo <= nlo && nhi <= hi {
+		st.applyNode(node, nhi-nlo, u)
+		return
+	}
+
+	st.push(node, nlo, nhi)
+	mid := (nlo + nhi) / 2
+	st.update(2*node, nlo, mid, lo, hi, u)
+	st.update(2*node+1, mid, nhi, lo, hi, u)
+	st.tree[node] = st.op(st.tree[2*node], st.tree[2*node+1])
+}
+
+// applyNode applies u to the node covering length elements and records it for its children.
+func (st *LazySegmentTree[T, U]) applyNode(node, length int, u U) {
+	st.tree[node] = st.apply(u, st.tree[node], length)
+	if length > 1 {
+		if st.pending[node] {
+			st.lazy[node] = st.compose(u, st.lazy[node])
+		} else {
+			st.lazy[node], st.pending[node] = u, true
+		}
+	}
+}
+
+// push moves the pending update of node to its children.
+func (st *LazySegmentTree[T, U]) push(node, nlo, nhi int) {
+	if !st.pending[node] {
+		return
+	}
+
+	mid := (nlo + nhi) / 2
+	st.applyNode(2*node, mid-nlo, st.lazy[node])
+	st.applyNode(2*node+1, nhi-mid, st.lazy[node])
+
+	var zero U
+	st.lazy[node], st.pending[node] = zero, false
+}
+
+// SparseTable answers range queries for an idempotent associative operation, such as min, max or
+// gcd, in O(1) time after O(n log n) preprocessing. The values cannot be updated.
+type SparseTable[T any] struct {
+	table [][]T
+	op    func(a, b T) T
+}
+
+// NewSparseTable returns a SparseTable over the elements of s for the given operation, which must
+// be associative and idempotent, that is op(x, x) == x.
+func NewSparseTable[S ~[]T, T any](s S, op func(a, b T) T) *SparseTable[T] {
+	levels := bits.Len(uint(len(s)))
+	table := make([][]T, maxInt(levels, 1))
+	table[0] = Clone([]T(s))
+
+	for k := 1; k < levels; k++ {
+		prev, half := table[k-1], 1<<(k-1)
+		table[k] = make([]T, len(s)-1<<k+1)
+		for i := range table[k] {
+			table[k][i] = op(prev[i], prev[i+half])
+		}
+	}
+
+	return &SparseTable[T]{table, op}
+}
+
+// Len returns the number of elements.
+func (st *SparseTable[T]) Len() int {
+	return len(st.table[0])
+}
+
+// Query returns the elements in the range [lo:hi) combined with the operation.
+// It panics if the range is empty.
+func (st *SparseTable[T]) Query(lo, hi int) T {
+	if lo >= hi {
+		panic("slice: SparseTable query of empty range")
+	}
+
+	k := bits.Len(uint(hi-lo)) - 1
+	return st.op(st.table[k][lo], st.table[k][hi-1<<k])
+}
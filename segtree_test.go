@@ -0,0 +1,183 @@
+package slice_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func TestSegmentTree(t *testing.T) {
+	s := randomInts(37, 100)
+	st := slice.NewSegmentTree(s, func(a, b int) int { return a + b }, 0)
+	r := rand.New(rand.NewSource(3))
+
+	for i := 0; i < 200; i++ {
+		j, v := r.Intn(len(s)), r.Intn(100)
+		st.Set(j, v)
+		s[j] = v
+
+		lo := r.Intn(len(s) + 1)
+		hi := lo + r.Intn(len(s)-lo+1)
+		if got, want := st.Query(lo, hi), slice.Sum(s[lo:hi]); got != want {
+			t.Fatalf("Query(%v, %v) = %v, want %v", lo, hi, got, want)
+		}
+	}
+
+	if st.Len() != len(s) || st.Get(5) != s[5] {
+		t.Errorf("Len() = %v, Get(5) = %v, want %v, %v", st.Len(), st.Get(5), len(s), s[5])
+	}
+}
+
+func TestSegmentTreeNonCommutative(t *testing.T) {
+	s := strings.Split("abcdefg", "")
+	st := slice.NewSegmentTree(s, func(a, b string) string { return a + b }, "")
+
+	if r := st.Query(1, 6); r != "bcdef" {
+		t.Errorf("Query(1, 6) = %q, want %q", r, "bcdef")
+	}
+	if r := st.Query(3, 3); r != "" {
+		t.Errorf("Query(3, 3) = %q, want %q", r, "")
+	}
+}
+
+func TestLazySegmentTree(t *testing.T) {
+	for _, n := range []int{1, 2, 37} {
+		s := randomInts(n, 100)
+		st := slice.NewLazySegmentTree(s,
+			func(a, b int) int { return a + b }, 0,
+			func(u, v, length int) int { return v + u*length },
+			func(newer, older int) int { return newer + older },
+		)
+		r := rand.New(rand.NewSource(int64(n)))
+
+		for i := 0; i < 300; i++ {
+			lo := r.Intn(n + 1)
+			hi := lo + r.Intn(n-lo+1)
+
+			switch r.Intn(3) {
+			case 0:
+				u := r.Intn(21) - 10
+				st.Update(lo, hi, u)
+				for j := lo; j < hi; j++ {
+					s[j] += u
+				}
+			case 1:
+				j, v := r.Intn(n), r.Intn(100)
+				st.Set(j, v)
+				s[j] = v
+			}
+
+			if got, want := st.Query(lo, hi), slice.Sum(s[lo:hi]); got != want {
+				t.Fatalf("n=%v: Query(%v, %v) = %v, want %v", n, lo, hi, got, want)
+			}
+		}
+
+		for j := range s {
+			if got := st.Get(j); got != s[j] {
+				t.Fatalf("n=%v: Get(%v) = %v, want %v", n, j, got, s[j])
+			}
+		}
+	}
+}
+
+func TestLazySegmentTreeAssignMin(t *testing.T) {
+	minOp := func(a, b int) int {
+		if a < b {
+			return a
+		}
+		return b
+	}
+
+	st := slice.NewLazySegmentTree([]int{5, 3, 8, 6, 2, 7},
+		minOp, 1<<62,
+		func(u, v, length int) int { return u },
+		func(newer, older int) int { return newer },
+	)
+
+	st.Update(1, 5, 9)
+	if r := st.Query(0, 6); r != 5 {
+		t.Errorf("Query(0, 6) = %v, want 5", r)
+	}
+	if r := st.Query(1, 5); r != 9 {
+		t.Errorf("Query(1, 5) = %v, want 9", r)
+	}
+	st.Update(3, 4, 1)
+	if r := st.Query(2, 6); r != 1 {
+		t.Errorf("Query(2, 6) = %v, want 1", r)
+	}
+}
+
+func TestSegmentTreeOutOfRange(t *testing.T) {
+	sum := func(a, b int) int { return a + b }
+	st := slice.NewSegmentTree([]int{1, 2, 3, 4}, sum, 0)
+	lst := slice.NewLazySegmentTree([]int{1, 2, 3, 4}, sum, 0,
+		func(u, v, length int) int { return v + u*length },
+		sum,
+	)
+
+	testCases := []struct {
+		name string
+		f    func()
+	}{
+		{"SegmentTree.Get(-1)", func() { st.Get(-1) }},
+		{"SegmentTree.Get(4)", func() { st.Get(4) }},
+		{"SegmentTree.Set(4)", func() { st.Set(4, 0) }},
+		{"SegmentTree.Query(-2, 2)", func() { st.Query(-2, 2) }},
+		{"SegmentTree.Query(2, 5)", func() { st.Query(2, 5) }},
+		{"SegmentTree.Query(3, 2)", func() { st.Query(3, 2) }},
+		{"LazySegmentTree.Get(4)", func() { lst.Get(4) }},
+		{"LazySegmentTree.Set(10)", func() { lst.Set(10, 100) }},
+		{"LazySegmentTree.Set(-1)", func() { lst.Set(-1, 100) }},
+		{"LazySegmentTree.Query(-1, 2)", func() { lst.Query(-1, 2) }},
+		{"LazySegmentTree.Query(3, 2)", func() { lst.Query(3, 2) }},
+		{"LazySegmentTree.Update(0, 5)", func() { lst.Update(0, 5, 1) }},
+	}
+
+	for _, c := range testCases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%v did not panic", c.name)
+				}
+			}()
+			c.f()
+		}()
+	}
+
+	if r := st.Query(0, 4); r != 10 {
+		t.Errorf("SegmentTree.Query(0, 4) = %v, want 10", r)
+	}
+	if r := lst.Query(0, 4); r != 10 {
+		t.Errorf("LazySegmentTree.Query(0, 4) = %v, want 10", r)
+	}
+	if r := st.Query(4, 4); r != 0 {
+		t.Errorf("SegmentTree.Query(4, 4) = %v, want 0", r)
+	}
+}
+
+func TestSparseTable(t *testing.T) {
+	minOp := func(a, b int) int {
+		if a < b {
+			return a
+		}
+		return b
+	}
+
+	for _, n := range []int{1, 2, 5, 64, 100} {
+		s := randomInts(n, 1000)
+		st := slice.NewSparseTable(s, minOp)
+
+		if st.Len() != n {
+			t.Errorf("Len() = %v, want %v", st.Len(), n)
+		}
+		for lo := 0; lo < n; lo++ {
+			for hi := lo + 1; hi <= n; hi++ {
+				if got, want := st.Query(lo, hi), slice.Reduce(s[lo+1:hi], minOp, s[lo]); got != want {
+					t.Fatalf("n=%v: Query(%v, %v) = %v, want %v", n, lo, hi, got, want)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package slice
+
+import "math/bits"
+
+// MedianFunc returns the lower median of s according to the given less function, along with a
+// boolean indicating whether s is non-empty. The input slice is not modified.
+func MedianFunc[S ~[]E, E any](s S, less func(a, b E) bool) (E, bool) {
+	return MedianFuncInPlace(Clone(s), less)
+}
+
+// MedianFuncInPlace is like MedianFunc, but reorders s in place instead of copying it.
+func MedianFuncInPlace[S ~[]E, E any](s S, less func(a, b E) bool) (E, bool) {
+	if len(s) == 0 {
+		var zero E
+		return zero, false
+	}
+
+	n := (len(s) - 1) / 2
+	NthElement(s, n, less)
+	return s[n], true
+}
+
+// NthElement reorders s in place so that s[n] is the element that would be at index n if s were
+// sorted, no element before n is greater than s[n], and no element after n is less than s[n].
+// It uses introselect and runs in O(len(s)) expected and O(len(s) log len(s)) worst-case time.
+// NthElement panics if n is out of range.
+func NthElement[S ~[]E, E any](s S, n int, less func(a, b E) bool) {
+	_ = s[n]
+
+	lo, hi := 0, len(s)
+	depth := 2 * bits.Len(uint(len(s)))
+
+	for hi-lo > 16 {
+		if depth == 0 {
+			Sort(s[lo:hi], less)
+			return
+		}
+		depth--
+
+		p := lo + partition(s[lo:hi], less)
+		switch {
+		case n < p:
+			hi = p
+		case n > p:
+			lo = p + 1
+		default:
+			return
+		}
+	}
+
+	insertionSort(s[lo:hi], less)
+}
+
+// NthElementCopy returns the element that would be at index n if s were sorted.
+// The input slice is not modified. NthElementCopy panics if n is out of range.
+func NthElementCopy[S ~[]E, E any](s S, n int, less func(a, b E) bool) E {
+	c := Clone(s)
+	NthElement(c, n, less)
+	return c[n]
+}
+
+// PartialSort reorders s in place so that s[:k] holds the k first elements in the order defined by
+// less, sorted. The order of the remaining elements is unspecified. If k > len(s), s is fully sorted.
+func PartialSort[S ~[]E, E any](s S, k int, less func(a, b E) bool) {
+	if k <= 0 {
+		return
+	}
+	if k >= len(s) {
+		Sort(s, less)
+		return
+	}
+
+	NthElement(s, k-1, less)
+	Sort(s[:k], less)
+}
+
+// PartialSortCopy returns a new slice holding the k first elements of s in the order defined by
+// less, sorted. The input slice is not modified.
+func PartialSortCopy[S ~[]E, E any](s S, k int, less func(a, b E) bool) S {
+	return TopK(s, k, less)
+}
+
+// TopK returns a new slice holding the k first elements of s in the order defined by less, sorted.
+// It keeps a bounded heap of k elements, so it runs in O(len(s) log k) time and does not modify s.
+// To get the k largest elements, pass a less function that reports whether a is greater than b.
+func TopK[S ~[]E, E any](s S, k int, less func(a, b E) bool) S {
+	if k > len(s) {
+		k = len(s)
+	}
+	if k <= 0 {
+		return nil
+	}
+
+	// h is a heap whose root is the last of the kept elements in the order defined by less.
+	greater := func(a, b E) bool { return less(b, a) }
+	h := make(S, 0, k)
+
+	for _, v := range s {
+		if len(h) < k {
+			h = append(h, v)
+			siftUp(h, len(h)-1, greater)
+		} else if less(v, h[0]) {
+			h[0] = v
+			siftDown(h, 0, greater)
+		}
+	}
+
+	Sort(h, less)
+	return h
+}
+
+// TopKInPlace is like TopK, but reorders s in place and returns s[:k].
+func TopKInPlace[S ~[]E, E any](s S, k int, less func(a, b E) bool) S {
+	if k > len(s) {
+		k = len(s)
+	}
+	if k <= 0 {
+		return nil
+	}
+
+	PartialSort(s, k, less)
+	return s[:k]
+}
+
+func insertionSort[S ~[]E, E any](s S, less func(a, b E) bool) {
+	for i := 1; i < len(s); i++ {
+		for j := i; j > 0 && less(s[j], s[j-1]); j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+}
+
+// partition partitions s around a median-of-three pivot and returns the pivot's final index.
+func partition[S ~[]E, E any](s S, less func(a, b E) bool) int {
+	lo, mid, hi := 0, len(s)/2, len(s)-1
+	if less(s[mid], s[lo]) {
+		s[mid], s[lo] = s[lo], s[mid]
+	}
+	if less(s[hi], s[lo]) {
+		s[hi], s[lo] = s[lo], s[hi]
+	}
+	if less(s[hi], s[mid]) {
+		s[hi], s[mid] = s[mid], s[hi]
+	}
+	s[mid], s[hi] = s[hi], s[mid]
+
+	p := lo
+	for i := lo; i < hi; i++ {
+		if less(s[i], s[hi]) {
+			s[i], s[p] = s[p], s[i]
+			p++
+		}
+	}
+	s[p], s[hi] = s[hi], s[p]
+	return p
+}
+
+// siftDown restores the heap property of h, ordered by less with the least element at the root,
+// below index i.
+func siftDown[S ~[]E, E any](h S, i int, less func(a, b E) bool) {
+	for {
+		c := 2*i + 1
+		if c >= len(h) {
+			return
+		}
+		if c+1 < len(h) && less(h[c+1], h[c]) {
+			c++
+		}
+		if !less(h[c], h[i]) {
+			return
+		}
+		h[i], h[c] = h[c], h[i]
+		i = c
+	}
+}
+
+// siftUp restores the heap property of h, ordered by less with the least element at the root,
+// above index i.
+func siftUp[S ~[]E, E any](h S, i int, less func(a, b E) bool) {
+	for i > 0 {
+		p := (i - 1) / 2
+		if !less(h[i], h[p]) {
+			return
+		}
+		h[i], h[p] = h[p], h[i]
+		i = p
+	}
+}
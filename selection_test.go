@@ -0,0 +1,119 @@
+package slice_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func randomInts(n, max int) []int {
+	r := rand.New(rand.NewSource(int64(n)))
+	s := make([]int, n)
+	for i := range s {
+		s[i] = r.Intn(max)
+	}
+	return s
+}
+
+func sortedCopy(s []int) []int {
+	c := slice.Clone(s)
+	slice.Sort(c, lessInt)
+	return c
+}
+
+func TestMedianFunc(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		want int
+		ok   bool
+	}{
+		{[]int{3, 1, 2}, 2, true},
+		{[]int{4, 1, 3, 2}, 2, true},
+		{[]int{5}, 5, true},
+		{nil, 0, false},
+	}
+
+	for _, c := range testCases {
+		s := slice.Clone(c.s)
+		if r, ok := slice.MedianFunc(s, lessInt); r != c.want || ok != c.ok || !slice.Equals(s, c.s) {
+			t.Errorf("MedianFunc(%v) = %v, %v, want %v, %v", c.s, r, ok, c.want, c.ok)
+		}
+		if r, ok := slice.MedianFuncInPlace(s, lessInt); r != c.want || ok != c.ok {
+			t.Errorf("MedianFuncInPlace(%v) = %v, %v, want %v, %v", c.s, r, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestNthElement(t *testing.T) {
+	for _, n := range []int{1, 10, 100, 1000} {
+		for _, max := range []int{2, 1000} {
+			s := randomInts(n, max)
+			sorted := sortedCopy(s)
+
+			for _, i := range []int{0, n / 3, n / 2, n - 1} {
+				c := slice.Clone(s)
+				slice.NthElement(c, i, lessInt)
+
+				if c[i] != sorted[i] {
+					t.Fatalf("NthElement(n=%v, %v) = %v, want %v", n, i, c[i], sorted[i])
+				}
+				for j := range c {
+					if (j < i && c[j] > c[i]) || (j > i && c[j] < c[i]) {
+						t.Fatalf("NthElement(n=%v, %v) is not partitioned at %v", n, i, j)
+					}
+				}
+				if r := slice.NthElementCopy(s, i, lessInt); r != sorted[i] {
+					t.Fatalf("NthElementCopy(n=%v, %v) = %v, want %v", n, i, r, sorted[i])
+				}
+			}
+		}
+	}
+}
+
+func TestPartialSort(t *testing.T) {
+	s := randomInts(500, 100)
+	sorted := sortedCopy(s)
+
+	for _, k := range []int{0, 1, 10, 500, 600} {
+		n := k
+		if n > len(s) {
+			n = len(s)
+		}
+
+		c := slice.Clone(s)
+		slice.PartialSort(c, k, lessInt)
+		if !slice.Equals(c[:n], sorted[:n]) || !slice.EqualsAnyOrder(c, s) {
+			t.Errorf("PartialSort(%v) = %v, want prefix %v", k, c[:n], sorted[:n])
+		}
+
+		if r := slice.PartialSortCopy(s, k, lessInt); !slice.Equals(r, sorted[:n]) {
+			t.Errorf("PartialSortCopy(%v) = %v, want %v", k, r, sorted[:n])
+		}
+	}
+}
+
+func TestTopK(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		k    int
+		want []int
+	}{
+		{[]int{5, 1, 9, 3, 7}, 2, []int{9, 7}},
+		{[]int{5, 1, 9, 3, 7}, 5, []int{9, 7, 5, 3, 1}},
+		{[]int{5, 1, 9}, 10, []int{9, 5, 1}},
+		{[]int{5, 1, 9}, 0, nil},
+		{nil, 3, nil},
+	}
+
+	greater := func(a, b int) bool { return a > b }
+	for _, c := range testCases {
+		s := slice.Clone(c.s)
+		if r := slice.TopK(s, c.k, greater); !slice.Equals(r, c.want) || !slice.Equals(s, c.s) {
+			t.Errorf("TopK(%v, %v) = %v, want %v", c.s, c.k, r, c.want)
+		}
+		if r := slice.TopKInPlace(s, c.k, greater); !slice.Equals(r, c.want) {
+			t.Errorf("TopKInPlace(%v, %v) = %v, want %v", c.s, c.k, r, c.want)
+		}
+	}
+}
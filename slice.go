@@ -51,6 +51,16 @@ func Concat[S ~[]E, E any](ss ...S) S {
 	return r
 }
 
+// CumProd returns a new slice whose i-th element is the product of the first i+1 elements of s.
+func CumProd[S ~[]E, E Number](s S) S {
+	return Scan(s, func(v, acc E) E { return acc * v }, 1)
+}
+
+// CumSum returns a new slice whose i-th element is the sum of the first i+1 elements of s.
+func CumSum[S ~[]E, E Number](s S) S {
+	return Scan(s, func(v, acc E) E { return acc + v }, 0)
+}
+
 // Dedup returns a new slice containing only the unique elements of the input slice.
 func Dedup[S ~[]E, E comparable](s S) S {
 	if len(s) == 0 {
@@ -346,6 +356,38 @@ func ReverseCopy[S ~[]E, E any](s S) S {
 	return n
 }
 
+// Scan is like Reduce, but returns a new slice holding the accumulated value after each element.
+// The i-th element of the result includes s[i].
+func Scan[S ~[]E, E any, R any](s S, f func(v E, acc R) R, init R) []R {
+	if len(s) == 0 {
+		return nil
+	}
+
+	r := make([]R, len(s))
+	for i, v := range s {
+		init = f(v, init)
+		r[i] = init
+	}
+
+	return r
+}
+
+// ScanExclusive is like Scan, but the i-th element of the result is the accumulated value before s[i],
+// so the first element is init.
+func ScanExclusive[S ~[]E, E any, R any](s S, f func(v E, acc R) R, init R) []R {
+	if len(s) == 0 {
+		return nil
+	}
+
+	r := make([]R, len(s))
+	for i, v := range s {
+		r[i] = init
+		init = f(v, init)
+	}
+
+	return r
+}
+
 // Shuffle randomizes the order of elements in the given slice using rand.Shuffle.
 // Note that the function modifies the original slice, and does not return a new one.
 func Shuffle[S ~[]E, E any](s S) {
@@ -363,6 +405,47 @@ func Sort[S ~[]E, E any](s S, less func(a, b E) bool) {
 	})
 }
 
+// SortBy sorts the elements of slice s in increasing order of the keys returned by the key function.
+// The key is computed once per element, and the sort is stable.
+func SortBy[S ~[]E, E any, K constraints.Ordered](s S, key func(E) K) {
+	sort.Stable(&keySorter[E, K]{s, Map(s, key), false})
+}
+
+// SortByDesc is like SortBy, but sorts in decreasing order of the keys.
+func SortByDesc[S ~[]E, E any, K constraints.Ordered](s S, key func(E) K) {
+	sort.Stable(&keySorter[E, K]{s, Map(s, key), true})
+}
+
+// SortStable is like Sort, but keeps equal elements in their original order.
+func SortStable[S ~[]E, E any](s S, less func(a, b E) bool) {
+	sort.SliceStable(s, func(i, j int) bool {
+		return less(s[i], s[j])
+	})
+}
+
+// keySorter sorts a slice by precomputed keys, swapping the keys along with the elements.
+type keySorter[E any, K constraints.Ordered] struct {
+	s    []E
+	keys []K
+	desc bool
+}
+
+func (ks *keySorter[E, K]) Len() int {
+	return len(ks.s)
+}
+
+func (ks *keySorter[E, K]) Less(i, j int) bool {
+	if ks.desc {
+		return ks.keys[j] < ks.keys[i]
+	}
+	return ks.keys[i] < ks.keys[j]
+}
+
+func (ks *keySorter[E, K]) Swap(i, j int) {
+	ks.s[i], ks.s[j] = ks.s[j], ks.s[i]
+	ks.keys[i], ks.keys[j] = ks.keys[j], ks.keys[i]
+}
+
 // Sum returns the sum of all elements in a slice of type T.
 func Sum[S ~[]E, E Number](s S) E {
 	var sum E
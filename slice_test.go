@@ -63,6 +63,40 @@ func TestConcat(t *testing.T) {
 	}
 }
 
+func TestCumProd(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		want []int
+	}{
+		{[]int{1, 2, 3, 4}, []int{1, 2, 6, 24}},
+		{[]int{5}, []int{5}},
+		{nil, nil},
+	}
+
+	for _, c := range testCases {
+		if r := slice.CumProd(c.s); !slice.Equals(r, c.want) {
+			t.Errorf("CumProd(%v) = %v, want %v", c.s, r, c.want)
+		}
+	}
+}
+
+func TestCumSum(t *testing.T) {
+	testCases := []struct {
+		s    []float64
+		want []float64
+	}{
+		{[]float64{1, 2, 3, 4}, []float64{1, 3, 6, 10}},
+		{[]float64{-1, 1}, []float64{-1, 0}},
+		{nil, nil},
+	}
+
+	for _, c := range testCases {
+		if r := slice.CumSum(c.s); !slice.Equals(r, c.want) {
+			t.Errorf("CumSum(%v) = %v, want %v", c.s, r, c.want)
+		}
+	}
+}
+
 func TestDedup(t *testing.T) {
 	testCases := []struct {
 		s    []int
@@ -591,6 +625,38 @@ func TestReverseCopy(t *testing.T) {
 	}
 }
 
+func TestScan(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		want []string
+	}{
+		{[]int{1, 2, 3}, []string{"1", "12", "123"}},
+		{nil, nil},
+	}
+
+	for _, c := range testCases {
+		if r := slice.Scan(c.s, func(v int, acc string) string { return acc + fmt.Sprint(v) }, ""); !slice.Equals(r, c.want) {
+			t.Errorf("Scan(%v) = %v, want %v", c.s, r, c.want)
+		}
+	}
+}
+
+func TestScanExclusive(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		want []int
+	}{
+		{[]int{1, 2, 3}, []int{10, 11, 13}},
+		{nil, nil},
+	}
+
+	for _, c := range testCases {
+		if r := slice.ScanExclusive(c.s, func(v, acc int) int { return acc + v }, 10); !slice.Equals(r, c.want) {
+			t.Errorf("ScanExclusive(%v) = %v, want %v", c.s, r, c.want)
+		}
+	}
+}
+
 func TestShuffle(t *testing.T) {
 	testCases := []struct {
 		s    []int
@@ -627,6 +693,44 @@ func TestSort(t *testing.T) {
 	}
 }
 
+func TestSortBy(t *testing.T) {
+	type row struct {
+		name string
+		age  int
+	}
+
+	var calls int
+	s := []row{{"c", 30}, {"a", 20}, {"b", 30}, {"d", 10}}
+	want := []row{{"d", 10}, {"a", 20}, {"c", 30}, {"b", 30}}
+
+	slice.SortBy(s, func(r row) int {
+		calls++
+		return r.age
+	})
+	if !slice.Equals(s, want) {
+		t.Errorf("got %v, want %v", s, want)
+	}
+	if calls != len(s) {
+		t.Errorf("key called %v times, want %v", calls, len(s))
+	}
+}
+
+func TestSortByDesc(t *testing.T) {
+	s, want := []string{"bb", "a", "ccc", "dd"}, []string{"ccc", "bb", "dd", "a"}
+	slice.SortByDesc(s, func(v string) int { return len(v) })
+	if !slice.Equals(s, want) {
+		t.Errorf("got %v, want %v", s, want)
+	}
+}
+
+func TestSortStable(t *testing.T) {
+	s, want := []string{"bb", "a", "ccc", "dd", "e"}, []string{"a", "e", "bb", "dd", "ccc"}
+	slice.SortStable(s, func(a, b string) bool { return len(a) < len(b) })
+	if !slice.Equals(s, want) {
+		t.Errorf("got %v, want %v", s, want)
+	}
+}
+
 func TestSum(t *testing.T) {
 	testCases := []struct {
 		s    []int
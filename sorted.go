@@ -0,0 +1,110 @@
+package slice
+
+import (
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// BinarySearch searches for v in the sorted slice s and returns the index at which v is found,
+// or the index at which it would be inserted, along with a boolean indicating whether v was found.
+func BinarySearch[S ~[]E, E constraints.Ordered](s S, v E) (int, bool) {
+	i := LowerBound(s, v)
+	return i, i < len(s) && s[i] == v
+}
+
+// BinarySearchFunc is like BinarySearch, but for a slice sorted according to the given less function.
+func BinarySearchFunc[S ~[]E, E any](s S, v E, less func(a, b E) bool) (int, bool) {
+	i := LowerBoundFunc(s, v, less)
+	return i, i < len(s) && !less(v, s[i])
+}
+
+// EqualRange returns the range [start:end) of elements equal to v in the sorted slice s.
+func EqualRange[S ~[]E, E constraints.Ordered](s S, v E) (start, end int) {
+	return LowerBound(s, v), UpperBound(s, v)
+}
+
+// InsertSorted inserts v into the sorted slice s after any elements equal to it,
+// and returns the resulting slice.
+func InsertSorted[S ~[]E, E constraints.Ordered](s S, v E) S {
+	return Insert(s, UpperBound(s, v), v)
+}
+
+// IsSorted returns true if the elements of s are in increasing order.
+func IsSorted[S ~[]E, E constraints.Ordered](s S) bool {
+	return IsSortedFunc(s, func(a, b E) bool { return a < b })
+}
+
+// IsSortedFunc returns true if the elements of s are sorted according to the given less function.
+func IsSortedFunc[S ~[]E, E any](s S, less func(a, b E) bool) bool {
+	for i := 1; i < len(s); i++ {
+		if less(s[i], s[i-1]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// LowerBound returns the index of the first element in the sorted slice s that is not less than v,
+// or len(s) if there is no such element.
+func LowerBound[S ~[]E, E constraints.Ordered](s S, v E) int {
+	return LowerBoundFunc(s, v, func(a, b E) bool { return a < b })
+}
+
+// LowerBoundFunc is like LowerBound, but for a slice sorted according to the given less function.
+func LowerBoundFunc[S ~[]E, E any](s S, v E, less func(a, b E) bool) int {
+	return sort.Search(len(s), func(i int) bool { return !less(s[i], v) })
+}
+
+// MergeSorted merges the slices a and b, both sorted according to the given less function,
+// into a new sorted slice in linear time. Equal elements from a are placed before those from b.
+func MergeSorted[S ~[]E, E any](a, b S, less func(a, b E) bool) S {
+	if len(a)+len(b) == 0 {
+		return nil
+	}
+
+	r := make(S, len(a)+len(b))
+	mergeInto(r, a, b, less)
+	return r
+}
+
+// mergeInto merges the sorted slices a and b into dst, which must have length len(a)+len(b).
+func mergeInto[S ~[]E, E any](dst, a, b S, less func(a, b E) bool) {
+	i, j, k := 0, 0, 0
+
+	for i < len(a) && j < len(b) {
+		if less(b[j], a[i]) {
+			dst[k] = b[j]
+			j++
+		} else {
+			dst[k] = a[i]
+			i++
+		}
+		k++
+	}
+
+	k += copy(dst[k:], a[i:])
+	copy(dst[k:], b[j:])
+}
+
+// RemoveSorted returns the sorted slice s with the first occurrence of v removed.
+// If v is not found in s, RemoveSorted returns s unchanged.
+func RemoveSorted[S ~[]E, E constraints.Ordered](s S, v E) S {
+	if i, ok := BinarySearch(s, v); ok {
+		return RemoveIndex(s, i)
+	}
+
+	return s
+}
+
+// UpperBound returns the index of the first element in the sorted slice s that is greater than v,
+// or len(s) if there is no such element.
+func UpperBound[S ~[]E, E constraints.Ordered](s S, v E) int {
+	return UpperBoundFunc(s, v, func(a, b E) bool { return a < b })
+}
+
+// UpperBoundFunc is like UpperBound, but for a slice sorted according to the given less function.
+func UpperBoundFunc[S ~[]E, E any](s S, v E, less func(a, b E) bool) int {
+	return sort.Search(len(s), func(i int) bool { return less(v, s[i]) })
+}
@@ -0,0 +1,193 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func TestBinarySearch(t *testing.T) {
+	testCases := []struct {
+		s     []int
+		v     int
+		want  int
+		found bool
+	}{
+		{[]int{1, 3, 5, 7}, 5, 2, true},
+		{[]int{1, 3, 5, 7}, 4, 2, false},
+		{[]int{1, 3, 3, 3, 7}, 3, 1, true},
+		{[]int{1, 3, 5, 7}, 8, 4, false},
+		{[]int{1, 3, 5, 7}, 0, 0, false},
+		{nil, 1, 0, false},
+	}
+
+	for _, c := range testCases {
+		if r, found := slice.BinarySearch(c.s, c.v); r != c.want || found != c.found {
+			t.Errorf("BinarySearch(%v, %v) = %v, %v, want %v, %v", c.s, c.v, r, found, c.want, c.found)
+		}
+	}
+}
+
+func TestBinarySearchFunc(t *testing.T) {
+	testCases := []struct {
+		s     []int
+		v     int
+		want  int
+		found bool
+	}{
+		{[]int{7, 5, 3, 1}, 5, 1, true},
+		{[]int{7, 5, 3, 1}, 4, 2, false},
+		{nil, 1, 0, false},
+	}
+
+	for _, c := range testCases {
+		if r, found := slice.BinarySearchFunc(c.s, c.v, func(a, b int) bool { return a > b }); r != c.want || found != c.found {
+			t.Errorf("BinarySearchFunc(%v, %v) = %v, %v, want %v, %v", c.s, c.v, r, found, c.want, c.found)
+		}
+	}
+}
+
+func TestEqualRange(t *testing.T) {
+	testCases := []struct {
+		s          []int
+		v          int
+		start, end int
+	}{
+		{[]int{1, 3, 3, 3, 7}, 3, 1, 4},
+		{[]int{1, 3, 3, 3, 7}, 5, 4, 4},
+		{nil, 1, 0, 0},
+	}
+
+	for _, c := range testCases {
+		if start, end := slice.EqualRange(c.s, c.v); start != c.start || end != c.end {
+			t.Errorf("EqualRange(%v, %v) = %v, %v, want %v, %v", c.s, c.v, start, end, c.start, c.end)
+		}
+	}
+}
+
+func TestInsertSorted(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		v    int
+		want []int
+	}{
+		{[]int{1, 3, 5}, 4, []int{1, 3, 4, 5}},
+		{[]int{1, 3, 5}, 0, []int{0, 1, 3, 5}},
+		{[]int{1, 3, 5}, 6, []int{1, 3, 5, 6}},
+		{nil, 1, []int{1}},
+	}
+
+	for _, c := range testCases {
+		if r := slice.InsertSorted(slice.Clone(c.s), c.v); !slice.Equals(r, c.want) {
+			t.Errorf("InsertSorted(%v, %v) = %v, want %v", c.s, c.v, r, c.want)
+		}
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		want bool
+	}{
+		{[]int{1, 2, 2, 3}, true},
+		{[]int{1, 3, 2}, false},
+		{[]int{1}, true},
+		{nil, true},
+	}
+
+	for _, c := range testCases {
+		if r := slice.IsSorted(c.s); r != c.want {
+			t.Errorf("IsSorted(%v) = %v, want %v", c.s, r, c.want)
+		}
+	}
+}
+
+func TestIsSortedFunc(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		want bool
+	}{
+		{[]int{3, 2, 2, 1}, true},
+		{[]int{1, 2}, false},
+	}
+
+	for _, c := range testCases {
+		if r := slice.IsSortedFunc(c.s, func(a, b int) bool { return a > b }); r != c.want {
+			t.Errorf("IsSortedFunc(%v) = %v, want %v", c.s, r, c.want)
+		}
+	}
+}
+
+func TestLowerBound(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		v    int
+		want int
+	}{
+		{[]int{1, 3, 3, 5}, 3, 1},
+		{[]int{1, 3, 3, 5}, 4, 3},
+		{[]int{1, 3, 3, 5}, 6, 4},
+		{nil, 1, 0},
+	}
+
+	for _, c := range testCases {
+		if r := slice.LowerBound(c.s, c.v); r != c.want {
+			t.Errorf("LowerBound(%v, %v) = %v, want %v", c.s, c.v, r, c.want)
+		}
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	testCases := []struct {
+		a, b []int
+		want []int
+	}{
+		{[]int{1, 4, 6}, []int{2, 3, 7}, []int{1, 2, 3, 4, 6, 7}},
+		{[]int{1, 2}, nil, []int{1, 2}},
+		{nil, []int{1, 2}, []int{1, 2}},
+		{nil, nil, nil},
+	}
+
+	for _, c := range testCases {
+		if r := slice.MergeSorted(c.a, c.b, func(a, b int) bool { return a < b }); !slice.Equals(r, c.want) {
+			t.Errorf("MergeSorted(%v, %v) = %v, want %v", c.a, c.b, r, c.want)
+		}
+	}
+}
+
+func TestRemoveSorted(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		v    int
+		want []int
+	}{
+		{[]int{1, 3, 3, 5}, 3, []int{1, 3, 5}},
+		{[]int{1, 3, 5}, 4, []int{1, 3, 5}},
+		{nil, 1, nil},
+	}
+
+	for _, c := range testCases {
+		if r := slice.RemoveSorted(slice.Clone(c.s), c.v); !slice.Equals(r, c.want) {
+			t.Errorf("RemoveSorted(%v, %v) = %v, want %v", c.s, c.v, r, c.want)
+		}
+	}
+}
+
+func TestUpperBound(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		v    int
+		want int
+	}{
+		{[]int{1, 3, 3, 5}, 3, 3},
+		{[]int{1, 3, 3, 5}, 0, 0},
+		{[]int{1, 3, 3, 5}, 5, 4},
+		{nil, 1, 0},
+	}
+
+	for _, c := range testCases {
+		if r := slice.UpperBound(c.s, c.v); r != c.want {
+			t.Errorf("UpperBound(%v, %v) = %v, want %v", c.s, c.v, r, c.want)
+		}
+	}
+}
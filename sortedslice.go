@@ -0,0 +1,111 @@
+package slice
+
+// SortedSlice is a slice that keeps its elements sorted according to a less function.
+// Searches take O(log n) time; insertions and deletions take O(n) time to shift elements.
+type SortedSlice[T any] struct {
+	s    []T
+	less func(a, b T) bool
+}
+
+// NewSortedSlice returns an empty SortedSlice ordered by the given less function.
+func NewSortedSlice[T any](less func(a, b T) bool) *SortedSlice[T] {
+	return &SortedSlice[T]{less: less}
+}
+
+// NewSortedSliceFrom returns a SortedSlice containing a copy of the elements of s, ordered by the
+// given less function. The input slice does not need to be sorted.
+func NewSortedSliceFrom[S ~[]T, T any](s S, less func(a, b T) bool) *SortedSlice[T] {
+	n := Clone([]T(s))
+	Sort(n, less)
+	return &SortedSlice[T]{n, less}
+}
+
+// At returns the element at index i in sorted order, which is the element of rank i.
+func (ss *SortedSlice[T]) At(i int) T {
+	return ss.s[i]
+}
+
+// Ceiling returns the smallest element not less than v, along with a boolean indicating whether
+// such an element was found.
+func (ss *SortedSlice[T]) Ceiling(v T) (T, bool) {
+	if i := LowerBoundFunc(ss.s, v, ss.less); i < len(ss.s) {
+		return ss.s[i], true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// Contains returns true if an element equal to v is in the slice.
+func (ss *SortedSlice[T]) Contains(v T) bool {
+	_, ok := ss.Search(v)
+	return ok
+}
+
+// Delete removes one element equal to v and returns true, or returns false if there is no such element.
+func (ss *SortedSlice[T]) Delete(v T) bool {
+	i, ok := ss.Search(v)
+	if ok {
+		ss.DeleteAt(i)
+	}
+	return ok
+}
+
+// DeleteAt removes the element at index i.
+func (ss *SortedSlice[T]) DeleteAt(i int) {
+	var zero T
+	copy(ss.s[i:], ss.s[i+1:])
+	ss.s[len(ss.s)-1] = zero
+	ss.s = ss.s[:len(ss.s)-1]
+}
+
+// Floor returns the largest element not greater than v, along with a boolean indicating whether
+// such an element was found.
+func (ss *SortedSlice[T]) Floor(v T) (T, bool) {
+	if i := UpperBoundFunc(ss.s, v, ss.less); i > 0 {
+		return ss.s[i-1], true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// Insert adds v after any equal elements and returns the index at which it was inserted.
+func (ss *SortedSlice[T]) Insert(v T) int {
+	i := UpperBoundFunc(ss.s, v, ss.less)
+	ss.s = Insert(ss.s, i, v)
+	return i
+}
+
+// Len returns the number of elements.
+func (ss *SortedSlice[T]) Len() int {
+	return len(ss.s)
+}
+
+// Range returns the elements that are not less than lo and less than hi.
+// The returned slice shares the backing array and must not be modified.
+func (ss *SortedSlice[T]) Range(lo, hi T) []T {
+	start := LowerBoundFunc(ss.s, lo, ss.less)
+	end := LowerBoundFunc(ss.s, hi, ss.less)
+	if end < start {
+		end = start
+	}
+	return ss.s[start:end:end]
+}
+
+// Rank returns the number of elements less than v.
+func (ss *SortedSlice[T]) Rank(v T) int {
+	return LowerBoundFunc(ss.s, v, ss.less)
+}
+
+// Search returns the index of the first element equal to v, or the index at which v would be
+// inserted, along with a boolean indicating whether v was found.
+func (ss *SortedSlice[T]) Search(v T) (int, bool) {
+	return BinarySearchFunc(ss.s, v, ss.less)
+}
+
+// Values returns the elements in sorted order.
+// The returned slice shares the backing array and must not be modified.
+func (ss *SortedSlice[T]) Values() []T {
+	return ss.s[:len(ss.s):len(ss.s)]
+}
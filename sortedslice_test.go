@@ -0,0 +1,69 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func lessInt(a, b int) bool { return a < b }
+
+func TestSortedSliceInsertDelete(t *testing.T) {
+	ss := slice.NewSortedSlice(lessInt)
+	for _, v := range []int{5, 1, 4, 1, 3} {
+		ss.Insert(v)
+	}
+
+	if r, want := ss.Values(), []int{1, 1, 3, 4, 5}; !slice.Equals(r, want) {
+		t.Errorf("Values() = %v, want %v", r, want)
+	}
+
+	if !ss.Delete(1) || ss.Delete(2) {
+		t.Errorf("Delete returned unexpected result")
+	}
+	ss.DeleteAt(ss.Len() - 1)
+
+	if r, want := ss.Values(), []int{1, 3, 4}; !slice.Equals(r, want) {
+		t.Errorf("Values() = %v, want %v", r, want)
+	}
+	if ss.Contains(5) || !ss.Contains(3) {
+		t.Errorf("Contains returned unexpected result")
+	}
+}
+
+func TestSortedSliceQueries(t *testing.T) {
+	ss := slice.NewSortedSliceFrom([]int{9, 3, 7, 1, 5}, lessInt)
+
+	if r, want := ss.Range(3, 8), []int{3, 5, 7}; !slice.Equals(r, want) {
+		t.Errorf("Range(3, 8) = %v, want %v", r, want)
+	}
+	if r := ss.Range(8, 3); len(r) != 0 {
+		t.Errorf("Range(8, 3) = %v, want []", r)
+	}
+	if r := ss.Rank(6); r != 3 {
+		t.Errorf("Rank(6) = %v, want 3", r)
+	}
+	if r := ss.At(3); r != 7 {
+		t.Errorf("At(3) = %v, want 7", r)
+	}
+
+	testCases := []struct {
+		v               int
+		floor, ceil     int
+		floorOK, ceilOK bool
+	}{
+		{6, 5, 7, true, true},
+		{5, 5, 5, true, true},
+		{0, 0, 1, false, true},
+		{10, 9, 0, true, false},
+	}
+
+	for _, c := range testCases {
+		if r, ok := ss.Floor(c.v); r != c.floor || ok != c.floorOK {
+			t.Errorf("Floor(%v) = %v, %v, want %v, %v", c.v, r, ok, c.floor, c.floorOK)
+		}
+		if r, ok := ss.Ceiling(c.v); r != c.ceil || ok != c.ceilOK {
+			t.Errorf("Ceiling(%v) = %v, %v, want %v, %v", c.v, r, ok, c.ceil, c.ceilOK)
+		}
+	}
+}
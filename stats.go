@@ -0,0 +1,219 @@
+package slice
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Real is a type constraint that allows only float and integer types.
+// It is the subset of Number whose values are ordered and convert to float64.
+type Real interface {
+	constraints.Float | constraints.Integer
+}
+
+// QuantileMethod selects how Quantile interpolates between the two elements around a quantile.
+// With the sorted elements x and h = (len(x)-1)*q, the methods are defined as follows.
+type QuantileMethod int
+
+const (
+	// QuantileLinear interpolates linearly between x[floor(h)] and x[ceil(h)].
+	QuantileLinear QuantileMethod = iota
+	// QuantileLower returns x[floor(h)].
+	QuantileLower
+	// QuantileHigher returns x[ceil(h)].
+	QuantileHigher
+	// QuantileNearest returns the element nearest to h, rounding halves to even.
+	QuantileNearest
+	// QuantileMidpoint returns the mean of x[floor(h)] and x[ceil(h)].
+	QuantileMidpoint
+)
+
+// Correlation returns the Pearson correlation coefficient of a and b.
+// It returns NaN if the slices have different lengths, are empty, or either has zero variance.
+func Correlation[S ~[]E, E Real](a, b S) float64 {
+	return Covariance(a, b) / (StdDev(a) * StdDev(b))
+}
+
+// Covariance returns the population covariance of a and b.
+// It returns NaN if the slices have different lengths or are empty.
+func Covariance[S ~[]E, E Real](a, b S) float64 {
+	return covariance(a, b, 0)
+}
+
+// Kurtosis returns the population excess kurtosis of s.
+// It returns NaN if s is empty or all its elements are equal.
+func Kurtosis[S ~[]E, E Real](s S) float64 {
+	m := Mean(s)
+	var m2, m4 float64
+	for _, v := range s {
+		d := float64(v) - m
+		m2 += d * d
+		m4 += d * d * d * d
+	}
+
+	n := float64(len(s))
+	m2, m4 = m2/n, m4/n
+	if m2 == 0 {
+		return math.NaN()
+	}
+	return m4/(m2*m2) - 3
+}
+
+// Mean returns the arithmetic mean of s, or NaN if s is empty.
+func Mean[S ~[]E, E Real](s S) float64 {
+	if len(s) == 0 {
+		return math.NaN()
+	}
+
+	var sum float64
+	for _, v := range s {
+		sum += float64(v)
+	}
+	return sum / float64(len(s))
+}
+
+// Median returns the median of s, which is the mean of the two middle elements if len(s) is even.
+// It returns NaN if s is empty or contains NaN. The input slice is not modified.
+func Median[S ~[]E, E Real](s S) float64 {
+	return Quantile(s, 0.5, QuantileMidpoint)
+}
+
+// Mode returns the most frequent element of s, preferring the one that occurs first on ties,
+// along with a boolean indicating whether s is non-empty.
+func Mode[S ~[]E, E Real](s S) (E, bool) {
+	if len(s) == 0 {
+		var zero E
+		return zero, false
+	}
+
+	counts := make(map[E]int)
+	for _, v := range s {
+		counts[v]++
+	}
+
+	mode, best := s[0], 0
+	for _, v := range s {
+		if c := counts[v]; c > best {
+			mode, best = v, c
+		}
+	}
+
+	return mode, true
+}
+
+// Percentile returns the p-th percentile of s, for p in [0, 100]. See Quantile.
+func Percentile[S ~[]E, E Real](s S, p float64, method QuantileMethod) float64 {
+	return Quantile(s, p/100, method)
+}
+
+// Quantile returns the q-th quantile of s, for q in [0, 1], using the given interpolation method.
+// It returns NaN if s is empty, contains NaN, or q is out of range. The input slice is not modified.
+func Quantile[S ~[]E, E Real](s S, q float64, method QuantileMethod) float64 {
+	return Quantiles(s, []float64{q}, method)[0]
+}
+
+// Quantiles is like Quantile, but returns the quantiles for each of qs while sorting s only once.
+func Quantiles[S ~[]E, E Real](s S, qs []float64, method QuantileMethod) []float64 {
+	r := make([]float64, len(qs))
+	x := Map(s, func(v E) float64 { return float64(v) })
+
+	if len(x) == 0 || Some(x, math.IsNaN) {
+		Fill(r, math.NaN())
+		return r
+	}
+	Sort(x, func(a, b float64) bool { return a < b })
+
+	for i, q := range qs {
+		if !(q >= 0 && q <= 1) {
+			r[i] = math.NaN()
+			continue
+		}
+
+		h := float64(len(x)-1) * q
+		lo, hi := x[int(math.Floor(h))], x[int(math.Ceil(h))]
+
+		switch method {
+		case QuantileLinear:
+			r[i] = lo + (h-math.Floor(h))*(hi-lo)
+		case QuantileLower:
+			r[i] = lo
+		case QuantileHigher:
+			r[i] = hi
+		case QuantileNearest:
+			r[i] = x[int(math.RoundToEven(h))]
+		case QuantileMidpoint:
+			r[i] = (lo + hi) / 2
+		default:
+			r[i] = math.NaN()
+		}
+	}
+
+	return r
+}
+
+// SampleCovariance returns the sample covariance of a and b, using Bessel's correction.
+// It returns NaN if the slices have different lengths or fewer than two elements.
+func SampleCovariance[S ~[]E, E Real](a, b S) float64 {
+	return covariance(a, b, 1)
+}
+
+// SampleStdDev returns the sample standard deviation of s, or NaN if s has fewer than two elements.
+func SampleStdDev[S ~[]E, E Real](s S) float64 {
+	return math.Sqrt(SampleVariance(s))
+}
+
+// SampleVariance returns the sample variance of s, using Bessel's correction.
+// It returns NaN if s has fewer than two elements.
+func SampleVariance[S ~[]E, E Real](s S) float64 {
+	return covariance(s, s, 1)
+}
+
+// Skewness returns the population skewness of s.
+// It returns NaN if s is empty or all its elements are equal.
+func Skewness[S ~[]E, E Real](s S) float64 {
+	m := Mean(s)
+	var m2, m3 float64
+	for _, v := range s {
+		d := float64(v) - m
+		m2 += d * d
+		m3 += d * d * d
+	}
+
+	n := float64(len(s))
+	m2, m3 = m2/n, m3/n
+	if m2 == 0 {
+		return math.NaN()
+	}
+	return m3 / math.Pow(m2, 1.5)
+}
+
+// StdDev returns the population standard deviation of s, or NaN if s is empty.
+func StdDev[S ~[]E, E Real](s S) float64 {
+	return math.Sqrt(Variance(s))
+}
+
+// Variance returns the population variance of s, or NaN if s is empty.
+func Variance[S ~[]E, E Real](s S) float64 {
+	return covariance(s, s, 0)
+}
+
+// covariance returns the covariance of a and b with len(a)-ddof as the divisor, computed in a
+// single numerically stable pass.
+func covariance[S ~[]E, E Real](a, b S, ddof int) float64 {
+	if len(a) != len(b) || len(a) <= ddof {
+		return math.NaN()
+	}
+
+	var meanA, meanB, c float64
+	for i := range a {
+		x, y := float64(a[i]), float64(b[i])
+		n := float64(i + 1)
+		dx := x - meanA
+		meanA += dx / n
+		meanB += (y - meanB) / n
+		c += dx * (y - meanB)
+	}
+
+	return c / float64(len(a)-ddof)
+}
@@ -0,0 +1,208 @@
+package slice_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func floatEquals(a, b float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.IsNaN(a) && math.IsNaN(b)
+	}
+	return math.Abs(a-b) <= 1e-9*math.Max(1, math.Abs(b))
+}
+
+func TestCorrelation(t *testing.T) {
+	testCases := []struct {
+		a, b []float64
+		want float64
+	}{
+		{[]float64{1, 2, 3}, []float64{2, 4, 6}, 1},
+		{[]float64{1, 2, 3}, []float64{3, 2, 1}, -1},
+		{[]float64{1, 2, 3}, []float64{1, 1, 1}, math.NaN()},
+		{[]float64{1, 2}, []float64{1}, math.NaN()},
+	}
+
+	for _, c := range testCases {
+		if r := slice.Correlation(c.a, c.b); !floatEquals(r, c.want) {
+			t.Errorf("Correlation(%v, %v) = %v, want %v", c.a, c.b, r, c.want)
+		}
+	}
+}
+
+func TestCovariance(t *testing.T) {
+	testCases := []struct {
+		a, b         []int
+		want, sample float64
+	}{
+		{[]int{1, 2, 3, 4}, []int{2, 4, 6, 8}, 2.5, 10.0 / 3},
+		{[]int{5}, []int{5}, 0, math.NaN()},
+		{nil, nil, math.NaN(), math.NaN()},
+		{[]int{1}, []int{1, 2}, math.NaN(), math.NaN()},
+	}
+
+	for _, c := range testCases {
+		if r := slice.Covariance(c.a, c.b); !floatEquals(r, c.want) {
+			t.Errorf("Covariance(%v, %v) = %v, want %v", c.a, c.b, r, c.want)
+		}
+		if r := slice.SampleCovariance(c.a, c.b); !floatEquals(r, c.sample) {
+			t.Errorf("SampleCovariance(%v, %v) = %v, want %v", c.a, c.b, r, c.sample)
+		}
+	}
+}
+
+func TestKurtosis(t *testing.T) {
+	testCases := []struct {
+		s    []float64
+		want float64
+	}{
+		{[]float64{1, 2, 3, 4, 5}, -1.3},
+		{[]float64{1, 1, 1}, math.NaN()},
+		{nil, math.NaN()},
+	}
+
+	for _, c := range testCases {
+		if r := slice.Kurtosis(c.s); !floatEquals(r, c.want) {
+			t.Errorf("Kurtosis(%v) = %v, want %v", c.s, r, c.want)
+		}
+	}
+}
+
+func TestMean(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		want float64
+	}{
+		{[]int{1, 2, 3, 4}, 2.5},
+		{[]int{-1}, -1},
+		{nil, math.NaN()},
+	}
+
+	for _, c := range testCases {
+		if r := slice.Mean(c.s); !floatEquals(r, c.want) {
+			t.Errorf("Mean(%v) = %v, want %v", c.s, r, c.want)
+		}
+	}
+}
+
+func TestMedian(t *testing.T) {
+	testCases := []struct {
+		s    []float64
+		want float64
+	}{
+		{[]float64{3, 1, 2}, 2},
+		{[]float64{4, 1, 3, 2}, 2.5},
+		{[]float64{1, math.NaN(), 2}, math.NaN()},
+		{nil, math.NaN()},
+	}
+
+	for _, c := range testCases {
+		if r := slice.Median(c.s); !floatEquals(r, c.want) {
+			t.Errorf("Median(%v) = %v, want %v", c.s, r, c.want)
+		}
+	}
+}
+
+func TestMode(t *testing.T) {
+	testCases := []struct {
+		s    []int
+		want int
+		ok   bool
+	}{
+		{[]int{1, 2, 2, 3, 3, 3}, 3, true},
+		{[]int{4, 1, 1, 4}, 4, true},
+		{[]int{7}, 7, true},
+		{nil, 0, false},
+	}
+
+	for _, c := range testCases {
+		if r, ok := slice.Mode(c.s); r != c.want || ok != c.ok {
+			t.Errorf("Mode(%v) = %v, %v, want %v, %v", c.s, r, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	s := []int{4, 1, 3, 2}
+
+	testCases := []struct {
+		q      float64
+		method slice.QuantileMethod
+		want   float64
+	}{
+		{0.5, slice.QuantileLinear, 2.5},
+		{0.4, slice.QuantileLinear, 2.2},
+		{0.4, slice.QuantileLower, 2},
+		{0.4, slice.QuantileHigher, 3},
+		{0.4, slice.QuantileNearest, 2},
+		{0.5, slice.QuantileNearest, 3},
+		{0.4, slice.QuantileMidpoint, 2.5},
+		{0, slice.QuantileLinear, 1},
+		{1, slice.QuantileLinear, 4},
+		{1.5, slice.QuantileLinear, math.NaN()},
+	}
+
+	for _, c := range testCases {
+		if r := slice.Quantile(s, c.q, c.method); !floatEquals(r, c.want) {
+			t.Errorf("Quantile(%v, %v, %v) = %v, want %v", s, c.q, c.method, r, c.want)
+		}
+	}
+
+	if r := slice.Percentile(s, 40, slice.QuantileLinear); !floatEquals(r, 2.2) {
+		t.Errorf("Percentile(%v, 40) = %v, want 2.2", s, r)
+	}
+	if r, want := slice.Quantiles(s, []float64{0, 0.5, 1}, slice.QuantileLinear), []float64{1, 2.5, 4}; !slice.Equals(r, want) {
+		t.Errorf("Quantiles(%v) = %v, want %v", s, r, want)
+	}
+	if r := slice.Quantile([]int{}, 0.5, slice.QuantileLinear); !math.IsNaN(r) {
+		t.Errorf("Quantile([]) = %v, want NaN", r)
+	}
+}
+
+func TestSkewness(t *testing.T) {
+	testCases := []struct {
+		s    []float64
+		want float64
+	}{
+		{[]float64{1, 2, 3}, 0},
+		{[]float64{1, 1, 4}, 1 / math.Sqrt2},
+		{[]float64{2, 2}, math.NaN()},
+		{nil, math.NaN()},
+	}
+
+	for _, c := range testCases {
+		if r := slice.Skewness(c.s); !floatEquals(r, c.want) {
+			t.Errorf("Skewness(%v) = %v, want %v", c.s, r, c.want)
+		}
+	}
+}
+
+func TestVariance(t *testing.T) {
+	testCases := []struct {
+		s              []float64
+		variance       float64
+		sampleVariance float64
+	}{
+		{[]float64{2, 4, 4, 4, 5, 5, 7, 9}, 4, 32.0 / 7},
+		{[]float64{1}, 0, math.NaN()},
+		{[]float64{1, math.NaN()}, math.NaN(), math.NaN()},
+		{nil, math.NaN(), math.NaN()},
+	}
+
+	for _, c := range testCases {
+		if r := slice.Variance(c.s); !floatEquals(r, c.variance) {
+			t.Errorf("Variance(%v) = %v, want %v", c.s, r, c.variance)
+		}
+		if r := slice.SampleVariance(c.s); !floatEquals(r, c.sampleVariance) {
+			t.Errorf("SampleVariance(%v) = %v, want %v", c.s, r, c.sampleVariance)
+		}
+		if r := slice.StdDev(c.s); !floatEquals(r, math.Sqrt(c.variance)) {
+			t.Errorf("StdDev(%v) = %v, want %v", c.s, r, math.Sqrt(c.variance))
+		}
+		if r := slice.SampleStdDev(c.s); !floatEquals(r, math.Sqrt(c.sampleVariance)) {
+			t.Errorf("SampleStdDev(%v) = %v, want %v", c.s, r, math.Sqrt(c.sampleVariance))
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package slice
+
+// ContainsSlice returns true if sub occurs in s.
+func ContainsSlice[S ~[]E, E comparable](s, sub S) bool {
+	return IndexOfSlice(s, sub) >= 0
+}
+
+// CountSlice returns the number of non-overlapping occurrences of sep in s.
+// If sep is empty, CountSlice returns len(s) + 1.
+func CountSlice[S ~[]E, E comparable](s, sep S) int {
+	if len(sep) == 0 {
+		return len(s) + 1
+	}
+
+	fail := kmpTable(sep)
+	var n int
+
+	for i := 0; ; {
+		j := indexOfSliceFrom(s, sep, i, fail)
+		if j < 0 {
+			return n
+		}
+		n++
+		i = j + len(sep)
+	}
+}
+
+// HasPrefix returns true if s begins with prefix.
+func HasPrefix[S ~[]E, E comparable](s, prefix S) bool {
+	return len(s) >= len(prefix) && Equals(s[:len(prefix)], prefix)
+}
+
+// HasSuffix returns true if s ends with suffix.
+func HasSuffix[S ~[]E, E comparable](s, suffix S) bool {
+	return len(s) >= len(suffix) && Equals(s[len(s)-len(suffix):], suffix)
+}
+
+// IndexOfSlice returns the index of the first occurrence of sep in s, or -1 if not found.
+// It uses the Knuth-Morris-Pratt algorithm and runs in O(len(s) + len(sep)) time.
+func IndexOfSlice[S ~[]E, E comparable](s, sep S) int {
+	return indexOfSliceFrom(s, sep, 0, nil)
+}
+
+// LastIndexOfSlice returns the index of the last occurrence of sep in s, or -1 if not found.
+func LastIndexOfSlice[S ~[]E, E comparable](s, sep S) int {
+	n, m := len(s), len(sep)
+	if m == 0 {
+		return n
+	}
+
+	rev := ReverseCopy(sep)
+	fail := kmpTable(rev)
+
+	var j int
+	for i := n - 1; i >= 0; i-- {
+		for j > 0 && s[i] != rev[j] {
+			j = fail[j-1]
+		}
+		if s[i] == rev[j] {
+			j++
+		}
+		if j == m {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// ReplaceAll returns a copy of s with all non-overlapping occurrences of old replaced by new.
+// If old is empty, new is inserted at the beginning of s and after every element.
+func ReplaceAll[S ~[]E, E comparable](s, old, new S) S {
+	if len(old) == 0 {
+		r := make(S, 0, len(s)+(len(s)+1)*len(new))
+		r = append(r, new...)
+		for _, v := range s {
+			r = append(r, v)
+			r = append(r, new...)
+		}
+		return r
+	}
+
+	fail := kmpTable(old)
+	var r S
+	var i int
+
+	for {
+		j := indexOfSliceFrom(s, old, i, fail)
+		if j < 0 {
+			break
+		}
+		r = append(r, s[i:j]...)
+		r = append(r, new...)
+		i = j + len(old)
+	}
+
+	return append(r, s[i:]...)
+}
+
+// SplitSeq splits s into all subslices separated by sep and returns a slice of the subslices between
+// those separators. If sep is empty, SplitSeq splits after each element.
+// The returned subslices share the underlying array of s.
+func SplitSeq[S ~[]E, E comparable](s, sep S) []S {
+	if len(sep) == 0 {
+		return Chunk(s, 1)
+	}
+
+	fail := kmpTable(sep)
+	var r []S
+	var i int
+
+	for {
+		j := indexOfSliceFrom(s, sep, i, fail)
+		if j < 0 {
+			break
+		}
+		r = append(r, s[i:j:j])
+		i = j + len(sep)
+	}
+
+	return append(r, s[i:])
+}
+
+// indexOfSliceFrom returns the index of the first occurrence of sep in s at or after from, or -1
+// if not found. fail is the KMP failure table of sep; if it is nil, it is computed.
+func indexOfSliceFrom[S ~[]E, E comparable](s, sep S, from int, fail []int) int {
+	m := len(sep)
+	if m == 0 {
+		return from
+	}
+	if fail == nil {
+		fail = kmpTable(sep)
+	}
+
+	var j int
+	for i := from; i < len(s); i++ {
+		for j > 0 && s[i] != sep[j] {
+			j = fail[j-1]
+		}
+		if s[i] == sep[j] {
+			j++
+		}
+		if j == m {
+			return i - m + 1
+		}
+	}
+
+	return -1
+}
+
+// kmpTable returns the Knuth-Morris-Pratt failure table of p, where fail[i] is the length of the
+// longest proper prefix of p[:i+1] that is also a suffix of it.
+func kmpTable[S ~[]E, E comparable](p S) []int {
+	fail := make([]int, len(p))
+
+	var k int
+	for i := 1; i < len(p); i++ {
+		for k > 0 && p[i] != p[k] {
+			k = fail[k-1]
+		}
+		if p[i] == p[k] {
+			k++
+		}
+		fail[i] = k
+	}
+
+	return fail
+}
@@ -0,0 +1,159 @@
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func TestContainsSlice(t *testing.T) {
+	testCases := []struct {
+		s, sub []int
+		want   bool
+	}{
+		{[]int{1, 2, 3, 4}, []int{2, 3}, true},
+		{[]int{1, 2, 3, 4}, []int{3, 2}, false},
+		{[]int{1, 2}, []int{1, 2, 3}, false},
+		{[]int{1, 2, 3}, nil, true},
+		{nil, nil, true},
+		{nil, []int{1}, false},
+	}
+
+	for _, c := range testCases {
+		if r := slice.ContainsSlice(c.s, c.sub); r != c.want {
+			t.Errorf("ContainsSlice(%v, %v) = %v, want %v", c.s, c.sub, r, c.want)
+		}
+	}
+}
+
+func TestCountSlice(t *testing.T) {
+	testCases := []struct {
+		s, sep []int
+		want   int
+	}{
+		{[]int{1, 2, 1, 2, 1}, []int{1, 2}, 2},
+		{[]int{1, 1, 1, 1}, []int{1, 1}, 2},
+		{[]int{1, 2, 3}, []int{4}, 0},
+		{[]int{1, 2, 3}, nil, 4},
+		{nil, []int{1}, 0},
+	}
+
+	for _, c := range testCases {
+		if r := slice.CountSlice(c.s, c.sep); r != c.want {
+			t.Errorf("CountSlice(%v, %v) = %v, want %v", c.s, c.sep, r, c.want)
+		}
+	}
+}
+
+func TestHasPrefix(t *testing.T) {
+	testCases := []struct {
+		s, prefix []int
+		want      bool
+	}{
+		{[]int{1, 2, 3}, []int{1, 2}, true},
+		{[]int{1, 2, 3}, []int{2}, false},
+		{[]int{1, 2, 3}, []int{1, 2, 3, 4}, false},
+		{[]int{1, 2, 3}, nil, true},
+		{nil, nil, true},
+	}
+
+	for _, c := range testCases {
+		if r := slice.HasPrefix(c.s, c.prefix); r != c.want {
+			t.Errorf("HasPrefix(%v, %v) = %v, want %v", c.s, c.prefix, r, c.want)
+		}
+	}
+}
+
+func TestHasSuffix(t *testing.T) {
+	testCases := []struct {
+		s, suffix []int
+		want      bool
+	}{
+		{[]int{1, 2, 3}, []int{2, 3}, true},
+		{[]int{1, 2, 3}, []int{2}, false},
+		{[]int{1, 2, 3}, []int{0, 1, 2, 3}, false},
+		{[]int{1, 2, 3}, nil, true},
+	}
+
+	for _, c := range testCases {
+		if r := slice.HasSuffix(c.s, c.suffix); r != c.want {
+			t.Errorf("HasSuffix(%v, %v) = %v, want %v", c.s, c.suffix, r, c.want)
+		}
+	}
+}
+
+func TestIndexOfSlice(t *testing.T) {
+	testCases := []struct {
+		s, sep []int
+		want   int
+	}{
+		{[]int{1, 2, 1, 2, 3}, []int{1, 2, 3}, 2},
+		{[]int{1, 1, 1, 2}, []int{1, 1, 2}, 1},
+		{[]int{1, 2, 3}, []int{3, 4}, -1},
+		{[]int{1, 2, 3}, nil, 0},
+		{nil, []int{1}, -1},
+	}
+
+	for _, c := range testCases {
+		if r := slice.IndexOfSlice(c.s, c.sep); r != c.want {
+			t.Errorf("IndexOfSlice(%v, %v) = %v, want %v", c.s, c.sep, r, c.want)
+		}
+	}
+}
+
+func TestLastIndexOfSlice(t *testing.T) {
+	testCases := []struct {
+		s, sep []int
+		want   int
+	}{
+		{[]int{1, 2, 1, 2, 3}, []int{1, 2}, 2},
+		{[]int{1, 1, 1, 2, 1, 1}, []int{1, 1}, 4},
+		{[]int{1, 2, 3}, []int{3, 4}, -1},
+		{[]int{1, 2, 3}, nil, 3},
+		{nil, []int{1}, -1},
+	}
+
+	for _, c := range testCases {
+		if r := slice.LastIndexOfSlice(c.s, c.sep); r != c.want {
+			t.Errorf("LastIndexOfSlice(%v, %v) = %v, want %v", c.s, c.sep, r, c.want)
+		}
+	}
+}
+
+func TestReplaceAll(t *testing.T) {
+	testCases := []struct {
+		s, old, new []int
+		want        []int
+	}{
+		{[]int{1, 2, 3, 1, 2}, []int{1, 2}, []int{9}, []int{9, 3, 9}},
+		{[]int{1, 1, 1}, []int{1, 1}, []int{2, 2, 2}, []int{2, 2, 2, 1}},
+		{[]int{1, 2, 3}, []int{2}, nil, []int{1, 3}},
+		{[]int{1, 2}, nil, []int{0}, []int{0, 1, 0, 2, 0}},
+		{[]int{1, 2, 3}, []int{4}, []int{5}, []int{1, 2, 3}},
+	}
+
+	for _, c := range testCases {
+		if r := slice.ReplaceAll(c.s, c.old, c.new); !slice.Equals(r, c.want) {
+			t.Errorf("ReplaceAll(%v, %v, %v) = %v, want %v", c.s, c.old, c.new, r, c.want)
+		}
+	}
+}
+
+func TestSplitSeq(t *testing.T) {
+	testCases := []struct {
+		s, sep []int
+		want   [][]int
+	}{
+		{[]int{1, 0, 0, 2, 3, 0, 0, 4}, []int{0, 0}, [][]int{{1}, {2, 3}, {4}}},
+		{[]int{0, 1, 0}, []int{0}, [][]int{{}, {1}, {}}},
+		{[]int{1, 2, 3}, []int{4}, [][]int{{1, 2, 3}}},
+		{[]int{1, 2, 3}, nil, [][]int{{1}, {2}, {3}}},
+		{nil, []int{0}, [][]int{{}}},
+	}
+
+	for _, c := range testCases {
+		if r := slice.SplitSeq(c.s, c.sep); !equals2D(r, c.want) {
+			t.Errorf("SplitSeq(%v, %v) = %v, want %v", c.s, c.sep, r, c.want)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package slice
+
+import (
+	"errors"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ErrOverflow is returned when an integer computation overflows its type.
+var ErrOverflow = errors.New("slice: integer overflow")
+
+// Inexact is a type constraint that allows only float and complex types.
+type Inexact interface {
+	constraints.Float | constraints.Complex
+}
+
+// pairwiseBlock is the length below which SumPairwise adds elements sequentially.
+const pairwiseBlock = 128
+
+// SumAs returns the sum of all elements in a slice, with each element converted to R and
+// accumulated in R. It allows summing, for example, int32 values as int64 to avoid overflow.
+func SumAs[R Real, S ~[]E, E Real](s S) R {
+	var sum R
+	for _, v := range s {
+		sum += R(v)
+	}
+	return sum
+}
+
+// SumChecked returns the sum of all elements in a slice of integers, or ErrOverflow if the sum
+// or any partial sum overflows the element type.
+func SumChecked[S ~[]E, E constraints.Integer](s S) (E, error) {
+	var sum E
+	for _, v := range s {
+		r := sum + v
+		if (v > 0 && r < sum) || (v < 0 && r > sum) {
+			return 0, ErrOverflow
+		}
+		sum = r
+	}
+	return sum, nil
+}
+
+// SumKahan returns the sum of all elements in a slice using Kahan compensated summation,
+// which keeps the rounding error independent of the length of the slice.
+func SumKahan[S ~[]E, E Inexact](s S) E {
+	var sum, c E
+	for _, v := range s {
+		y := v - c
+		t := sum + y
+		c = (t - sum) - y
+		sum = t
+	}
+	return sum
+}
+
+// SumPairwise returns the sum of all elements in a slice using pairwise summation, which grows
+// the rounding error only logarithmically with the length of the slice at little extra cost.
+func SumPairwise[S ~[]E, E Inexact](s S) E {
+	if len(s) <= pairwiseBlock {
+		return Sum(s)
+	}
+
+	mid := len(s) / 2
+	return SumPairwise(s[:mid]) + SumPairwise(s[mid:])
+}
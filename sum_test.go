@@ -0,0 +1,81 @@
+package slice_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func TestSumAs(t *testing.T) {
+	s := []int32{math.MaxInt32, math.MaxInt32, 2}
+	if r, want := slice.SumAs[int64](s), int64(2*math.MaxInt32+2); r != want {
+		t.Errorf("SumAs[int64](%v) = %v, want %v", s, r, want)
+	}
+
+	f := []int{1, 2, 3}
+	if r := slice.SumAs[float64](f); r != 6 {
+		t.Errorf("SumAs[float64](%v) = %v, want 6", f, r)
+	}
+
+	if r := slice.SumAs[int64]([]int8(nil)); r != 0 {
+		t.Errorf("SumAs[int64](nil) = %v, want 0", r)
+	}
+}
+
+func TestSumChecked(t *testing.T) {
+	testCases := []struct {
+		s    []int8
+		want int8
+		err  error
+	}{
+		{[]int8{100, 27}, 127, nil},
+		{[]int8{100, 28}, 0, slice.ErrOverflow},
+		{[]int8{-100, -28}, -128, nil},
+		{[]int8{-100, -29}, 0, slice.ErrOverflow},
+		{[]int8{100, 100, -100}, 0, slice.ErrOverflow},
+		{nil, 0, nil},
+	}
+
+	for _, c := range testCases {
+		if r, err := slice.SumChecked(c.s); r != c.want || !errors.Is(err, c.err) {
+			t.Errorf("SumChecked(%v) = %v, %v, want %v, %v", c.s, r, err, c.want, c.err)
+		}
+	}
+
+	if _, err := slice.SumChecked([]uint8{200, 56}); !errors.Is(err, slice.ErrOverflow) {
+		t.Errorf("SumChecked([]uint8{200, 56}) returned error %v, want %v", err, slice.ErrOverflow)
+	}
+}
+
+func TestSumKahan(t *testing.T) {
+	s := make([]float64, 10001)
+	s[0] = 1
+	slice.FillRange(s, 1e-16, 1, len(s))
+
+	if r, want := slice.SumKahan(s), 1+1e-12; math.Abs(r-want) > 1e-15 {
+		t.Errorf("SumKahan = %v, want %v", r, want)
+	}
+	if r := slice.Sum(s); r != 1 {
+		t.Errorf("naive Sum = %v, expected precision loss to 1", r)
+	}
+
+	c := []complex128{1 + 1i, 2 - 1i}
+	if r := slice.SumKahan(c); r != 3 {
+		t.Errorf("SumKahan(%v) = %v, want 3", c, r)
+	}
+}
+
+func TestSumPairwise(t *testing.T) {
+	s := make([]float32, 1<<20)
+	slice.Fill(s, 0.1)
+
+	if r, want := slice.SumPairwise(s), float32(0.1*(1<<20)); math.Abs(float64(r-want)) > 1 {
+		t.Errorf("SumPairwise = %v, want %v", r, want)
+	}
+
+	if r := slice.SumPairwise([]float64{1, 2, 3}); r != 6 {
+		t.Errorf("SumPairwise([1 2 3]) = %v, want 6", r)
+	}
+}
@@ -0,0 +1,210 @@
+package slice
+
+import (
+	"errors"
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ErrLengthMismatch is returned when slices that must have the same length do not.
+var ErrLengthMismatch = errors.New("slice: length mismatch")
+
+// NormKind selects the vector norm computed by Norm and Distance.
+type NormKind int
+
+const (
+	// NormL2 is the Euclidean norm, the square root of the sum of squares.
+	NormL2 NormKind = iota
+	// NormL1 is the sum of absolute values.
+	NormL1
+	// NormInf is the largest absolute value.
+	NormInf
+)
+
+// Add returns a new slice holding the element-wise sum of a and b, or ErrLengthMismatch.
+func Add[S ~[]E, E Number](a, b S) (S, error) {
+	dst := make(S, len(a))
+	if err := AddInto(dst, a, b); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// AddInto stores the element-wise sum of a and b in dst without allocating.
+// It returns ErrLengthMismatch unless all three slices have the same length.
+func AddInto[S ~[]E, E Number](dst, a, b S) error {
+	if len(a) != len(b) || len(dst) != len(a) {
+		return ErrLengthMismatch
+	}
+	for i := range dst {
+		dst[i] = a[i] + b[i]
+	}
+	return nil
+}
+
+// Clamp returns a new slice with every element of s limited to the range [lo, hi].
+func Clamp[S ~[]E, E Real](s S, lo, hi E) S {
+	return Map(s, func(v E) E {
+		if v < lo {
+			return lo
+		}
+		if v > hi {
+			return hi
+		}
+		return v
+	})
+}
+
+// CosineSimilarity returns the cosine of the angle between a and b, or ErrLengthMismatch.
+// It returns NaN if either vector is zero.
+func CosineSimilarity[S ~[]E, E Real](a, b S) (float64, error) {
+	if len(a) != len(b) {
+		return 0, ErrLengthMismatch
+	}
+
+	var dot, na, nb float64
+	for i := range a {
+		x, y := float64(a[i]), float64(b[i])
+		dot += x * y
+		na += x * x
+		nb += y * y
+	}
+	return dot / math.Sqrt(na*nb), nil
+}
+
+// Distance returns the norm of the difference between a and b, or ErrLengthMismatch.
+func Distance[S ~[]E, E Real](a, b S, kind NormKind) (float64, error) {
+	if len(a) != len(b) {
+		return 0, ErrLengthMismatch
+	}
+
+	d := make([]float64, len(a))
+	for i := range a {
+		d[i] = float64(a[i]) - float64(b[i])
+	}
+	return Norm(d, kind), nil
+}
+
+// Div returns a new slice holding the element-wise quotient of a and b, or ErrLengthMismatch.
+// Integer division by zero panics.
+func Div[S ~[]E, E Number](a, b S) (S, error) {
+	dst := make(S, len(a))
+	if err := DivInto(dst, a, b); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// DivInto stores the element-wise quotient of a and b in dst without allocating.
+// It returns ErrLengthMismatch unless all three slices have the same length.
+func DivInto[S ~[]E, E Number](dst, a, b S) error {
+	if len(a) != len(b) || len(dst) != len(a) {
+		return ErrLengthMismatch
+	}
+	for i := range dst {
+		dst[i] = a[i] / b[i]
+	}
+	return nil
+}
+
+// Dot returns the dot product of a and b, or ErrLengthMismatch.
+func Dot[S ~[]E, E Number](a, b S) (E, error) {
+	if len(a) != len(b) {
+		return 0, ErrLengthMismatch
+	}
+
+	var sum E
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum, nil
+}
+
+// Mul returns a new slice holding the element-wise product of a and b, or ErrLengthMismatch.
+func Mul[S ~[]E, E Number](a, b S) (S, error) {
+	dst := make(S, len(a))
+	if err := MulInto(dst, a, b); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// MulInto stores the element-wise product of a and b in dst without allocating.
+// It returns ErrLengthMismatch unless all three slices have the same length.
+func MulInto[S ~[]E, E Number](dst, a, b S) error {
+	if len(a) != len(b) || len(dst) != len(a) {
+		return ErrLengthMismatch
+	}
+	for i := range dst {
+		dst[i] = a[i] * b[i]
+	}
+	return nil
+}
+
+// Norm returns the norm of s of the given kind. It returns 0 for an empty slice.
+func Norm[S ~[]E, E Real](s S, kind NormKind) float64 {
+	var r float64
+	for _, v := range s {
+		a := math.Abs(float64(v))
+		switch kind {
+		case NormL1:
+			r += a
+		case NormInf:
+			r = math.Max(r, a)
+		default:
+			r = math.Hypot(r, a)
+		}
+	}
+	return r
+}
+
+// Normalize returns a new slice holding s scaled to unit Euclidean norm.
+// A zero vector is returned as a copy.
+func Normalize[S ~[]E, E constraints.Float](s S) S {
+	n := Norm(s, NormL2)
+	if n == 0 {
+		return Clone(s)
+	}
+	return Map(s, func(v E) E { return E(float64(v) / n) })
+}
+
+// Scale returns a new slice holding every element of s multiplied by k.
+func Scale[S ~[]E, E Number](s S, k E) S {
+	dst := make(S, len(s))
+	ScaleInto(dst, s, k)
+	return dst
+}
+
+// ScaleInto stores every element of s multiplied by k in dst without allocating.
+// It returns ErrLengthMismatch unless dst and s have the same length.
+func ScaleInto[S ~[]E, E Number](dst, s S, k E) error {
+	if len(dst) != len(s) {
+		return ErrLengthMismatch
+	}
+	for i, v := range s {
+		dst[i] = v * k
+	}
+	return nil
+}
+
+// Sub returns a new slice holding the element-wise difference of a and b, or ErrLengthMismatch.
+func Sub[S ~[]E, E Number](a, b S) (S, error) {
+	dst := make(S, len(a))
+	if err := SubInto(dst, a, b); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// SubInto stores the element-wise difference of a and b in dst without allocating.
+// It returns ErrLengthMismatch unless all three slices have the same length.
+func SubInto[S ~[]E, E Number](dst, a, b S) error {
+	if len(a) != len(b) || len(dst) != len(a) {
+		return ErrLengthMismatch
+	}
+	for i := range dst {
+		dst[i] = a[i] - b[i]
+	}
+	return nil
+}
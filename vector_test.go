@@ -0,0 +1,146 @@
+package slice_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/kim89098/slice"
+)
+
+func TestAdd(t *testing.T) {
+	if r, err := slice.Add([]int{1, 2}, []int{3, 4}); err != nil || !slice.Equals(r, []int{4, 6}) {
+		t.Errorf("Add = %v, %v, want [4 6]", r, err)
+	}
+	if _, err := slice.Add([]int{1}, []int{1, 2}); !errors.Is(err, slice.ErrLengthMismatch) {
+		t.Errorf("Add with mismatched lengths returned error %v", err)
+	}
+
+	dst := make([]float64, 2)
+	if err := slice.AddInto(dst, []float64{1, 2}, []float64{0.5, 0.5}); err != nil || !slice.Equals(dst, []float64{1.5, 2.5}) {
+		t.Errorf("AddInto = %v, %v, want [1.5 2.5]", dst, err)
+	}
+	if err := slice.AddInto(dst[:1], []float64{1, 2}, []float64{1, 2}); !errors.Is(err, slice.ErrLengthMismatch) {
+		t.Errorf("AddInto with short dst returned error %v", err)
+	}
+
+	a, b := make([]float64, 64), make([]float64, 64)
+	dst = make([]float64, 64)
+	if n := testing.AllocsPerRun(10, func() { slice.AddInto(dst, a, b) }); n != 0 {
+		t.Errorf("AddInto allocated %v times", n)
+	}
+}
+
+func TestClamp(t *testing.T) {
+	s, want := []int{-5, 0, 5, 10}, []int{0, 0, 5, 7}
+	if r := slice.Clamp(s, 0, 7); !slice.Equals(r, want) {
+		t.Errorf("Clamp(%v, 0, 7) = %v, want %v", s, r, want)
+	}
+}
+
+func TestCosineSimilarity(t *testing.T) {
+	testCases := []struct {
+		a, b []float64
+		want float64
+	}{
+		{[]float64{1, 0}, []float64{0, 1}, 0},
+		{[]float64{1, 1}, []float64{2, 2}, 1},
+		{[]float64{1, 0}, []float64{-1, 0}, -1},
+		{[]float64{0, 0}, []float64{1, 0}, math.NaN()},
+	}
+
+	for _, c := range testCases {
+		if r, err := slice.CosineSimilarity(c.a, c.b); err != nil || !floatEquals(r, c.want) {
+			t.Errorf("CosineSimilarity(%v, %v) = %v, %v, want %v", c.a, c.b, r, err, c.want)
+		}
+	}
+	if _, err := slice.CosineSimilarity([]int{1}, nil); !errors.Is(err, slice.ErrLengthMismatch) {
+		t.Errorf("CosineSimilarity with mismatched lengths returned error %v", err)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	a, b := []int{0, 0}, []int{3, -4}
+
+	testCases := []struct {
+		kind slice.NormKind
+		want float64
+	}{
+		{slice.NormL2, 5},
+		{slice.NormL1, 7},
+		{slice.NormInf, 4},
+	}
+
+	for _, c := range testCases {
+		if r, err := slice.Distance(a, b, c.kind); err != nil || r != c.want {
+			t.Errorf("Distance(%v, %v, %v) = %v, %v, want %v", a, b, c.kind, r, err, c.want)
+		}
+	}
+	if _, err := slice.Distance(a, b[:1], slice.NormL2); !errors.Is(err, slice.ErrLengthMismatch) {
+		t.Errorf("Distance with mismatched lengths returned error %v", err)
+	}
+}
+
+func TestDiv(t *testing.T) {
+	if r, err := slice.Div([]float64{1, 9}, []float64{2, 3}); err != nil || !slice.Equals(r, []float64{0.5, 3}) {
+		t.Errorf("Div = %v, %v, want [0.5 3]", r, err)
+	}
+	if r, err := slice.Div([]int{1}, nil); r != nil || !errors.Is(err, slice.ErrLengthMismatch) {
+		t.Errorf("Div with mismatched lengths = %v, %v", r, err)
+	}
+}
+
+func TestDot(t *testing.T) {
+	if r, err := slice.Dot([]int{1, 2, 3}, []int{4, 5, 6}); err != nil || r != 32 {
+		t.Errorf("Dot = %v, %v, want 32", r, err)
+	}
+	if _, err := slice.Dot([]int{1}, nil); !errors.Is(err, slice.ErrLengthMismatch) {
+		t.Errorf("Dot with mismatched lengths returned error %v", err)
+	}
+}
+
+func TestMul(t *testing.T) {
+	if r, err := slice.Mul([]complex128{1i, 2}, []complex128{1i, 3}); err != nil || !slice.Equals(r, []complex128{-1, 6}) {
+		t.Errorf("Mul = %v, %v, want [-1 6]", r, err)
+	}
+}
+
+func TestNorm(t *testing.T) {
+	s := []float64{3, -4}
+	if r := slice.Norm(s, slice.NormL2); r != 5 {
+		t.Errorf("Norm(%v, L2) = %v, want 5", s, r)
+	}
+	if r := slice.Norm(s, slice.NormL1); r != 7 {
+		t.Errorf("Norm(%v, L1) = %v, want 7", s, r)
+	}
+	if r := slice.Norm(s, slice.NormInf); r != 4 {
+		t.Errorf("Norm(%v, Inf) = %v, want 4", s, r)
+	}
+	if r := slice.Norm([]float64(nil), slice.NormL2); r != 0 {
+		t.Errorf("Norm(nil) = %v, want 0", r)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	if r, want := slice.Normalize([]float64{3, 4}), []float64{0.6, 0.8}; !slice.Equals(r, want) {
+		t.Errorf("Normalize = %v, want %v", r, want)
+	}
+	if r, want := slice.Normalize([]float32{0, 0}), []float32{0, 0}; !slice.Equals(r, want) {
+		t.Errorf("Normalize = %v, want %v", r, want)
+	}
+}
+
+func TestScale(t *testing.T) {
+	if r, want := slice.Scale([]int{1, -2}, 3), []int{3, -6}; !slice.Equals(r, want) {
+		t.Errorf("Scale = %v, want %v", r, want)
+	}
+	if err := slice.ScaleInto(make([]int, 1), []int{1, 2}, 3); !errors.Is(err, slice.ErrLengthMismatch) {
+		t.Errorf("ScaleInto with short dst returned error %v", err)
+	}
+}
+
+func TestSub(t *testing.T) {
+	if r, err := slice.Sub([]int{5, 5}, []int{1, 7}); err != nil || !slice.Equals(r, []int{4, -2}) {
+		t.Errorf("Sub = %v, %v, want [4 -2]", r, err)
+	}
+}